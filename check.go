@@ -0,0 +1,46 @@
+package symbiont
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/cleitonmarx/symbiont/depend"
+	"github.com/cleitonmarx/symbiont/internal/reflectx"
+)
+
+// CheckDependencies verifies that every resolve tag declared by initializers and hosted runnables
+// can be satisfied by the current dependency container.
+// Fields are never assigned. All unsatisfiable tags are reported at once as a joined error,
+// each wrapped with its owning component via NewError.
+func (a *App) CheckDependencies() error {
+	targets := make([]any, 0, len(a.initializers)+len(a.runnableSpecsList))
+	for _, init := range a.initializers {
+		targets = append(targets, init)
+	}
+	for _, rs := range a.runnableSpecsList {
+		for _, component := range hostedComponents(rs.original) {
+			targets = append(targets, component)
+		}
+	}
+
+	var errs []error
+	for _, target := range targets {
+		err := reflectx.IterateStructFields(
+			target,
+			func(fieldValue reflect.Value, structField reflect.StructField, targetType reflect.Type) error {
+				if isAppContextField(fieldValue, structField) {
+					return nil
+				}
+				if err := depend.CheckStructFieldValue(fieldValue, structField, targetType); err != nil {
+					errs = append(errs, newPhaseError(fmt.Errorf("field '%s': %w", structField.Name, err), target, PhaseWiring))
+				}
+				return nil
+			},
+		)
+		if err != nil {
+			errs = append(errs, newPhaseError(err, target, PhaseWiring))
+		}
+	}
+	return errors.Join(errs...)
+}
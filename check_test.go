@@ -0,0 +1,102 @@
+package symbiont
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont/depend"
+	"github.com/cleitonmarx/symbiont/introspection"
+)
+
+type checkDepsInitializer struct {
+	Number int `resolve:"number"`
+}
+
+func (c *checkDepsInitializer) Initialize(ctx context.Context) (context.Context, error) {
+	return ctx, nil
+}
+
+type checkDepsRunnable struct {
+	Dep       string  `resolve:""`
+	Ratio     float64 `resolve:""`
+	NotTagged bool
+}
+
+func (c *checkDepsRunnable) Run(ctx context.Context) error { return nil }
+
+// funcRunnable is a function-typed runnable that cannot be wired through struct tags.
+type funcRunnable func(ctx context.Context) error
+
+func (f funcRunnable) Run(ctx context.Context) error { return f(ctx) }
+
+func TestApp_CheckDependencies(t *testing.T) {
+	tests := map[string]struct {
+		register  func()
+		runnables []Runnable
+		wantErrs  []string
+	}{
+		"all-dependencies-registered": {
+			register: func() {
+				depend.Register("dep")
+				depend.Register(1.5)
+				depend.RegisterNamed(42, "number")
+			},
+			runnables: []Runnable{&checkDepsRunnable{}},
+		},
+		"reports-all-missing-dependencies": {
+			register: func() {
+				depend.Register("dep")
+			},
+			runnables: []Runnable{&checkDepsRunnable{}},
+			wantErrs: []string{
+				"error: field 'Number': depend: the dependency type 'int' was not registered, component: *symbiont.checkDepsInitializer",
+				"error: field 'Ratio': depend: the dependency type 'float64' was not registered, component: *symbiont.checkDepsRunnable",
+			},
+		},
+		"non-struct-pointer-target": {
+			register: func() {
+				depend.RegisterNamed(42, "number")
+			},
+			runnables: []Runnable{funcRunnable(func(context.Context) error { return nil })},
+			wantErrs:  []string{"target must be a struct pointer, got 'symbiont.funcRunnable'"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			depend.ClearContainer()
+			defer depend.ClearContainer()
+			if tt.register != nil {
+				tt.register()
+			}
+
+			app := NewApp().
+				Initialize(&checkDepsInitializer{}).
+				Host(tt.runnables...)
+
+			err := app.CheckDependencies()
+			if len(tt.wantErrs) == 0 && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if len(tt.wantErrs) > 0 && err == nil {
+				t.Fatalf("expected errors %v, got nil", tt.wantErrs)
+			}
+			for _, want := range tt.wantErrs {
+				if !strings.Contains(err.Error(), want) {
+					t.Fatalf("expected error to contain %q, got %q", want, err.Error())
+				}
+			}
+			for _, r := range tt.runnables {
+				if cr, ok := r.(*checkDepsRunnable); ok && cr.Dep != "" {
+					t.Fatalf("expected fields to remain unassigned, got %q", cr.Dep)
+				}
+			}
+			for _, ev := range depend.GetEvents() {
+				if ev.Kind == introspection.DepResolved {
+					t.Fatalf("expected no resolve events, got %+v", ev)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package symbiont
+
+import (
+	"errors"
+	"slices"
+)
+
+// Clone returns a copy of the app that can be extended without affecting the original.
+// Initializers, runnable specs, and introspectors are copied into new slices, and runnables hosted
+// without their own ReadyChecker get fresh readiness state, so both apps can run independently.
+// The components themselves are shared, as are the logger, event writer, and tracer.
+//
+// Cloning an app that has already been run is not allowed; the clone fails when it is run.
+func (a *App) Clone() *App {
+	c := &App{
+		initializers:            slices.Clone(a.initializers),
+		introspectors:           slices.Clone(a.introspectors),
+		logger:                  a.logger,
+		initTimeout:             a.initTimeout,
+		shutdownTimeout:         a.shutdownTimeout,
+		requireConfig:           a.requireConfig,
+		strictInitContext:       a.strictInitContext,
+		forceQuitOnSecondSignal: a.forceQuitOnSecondSignal,
+		events:                  a.events,
+		tracer:                  a.tracer,
+		buildErrs:               slices.Clone(a.buildErrs),
+		resolvedHosts:           slices.Clone(a.resolvedHosts),
+	}
+	if a.started.Load() {
+		c.buildErrs = append(c.buildErrs, errors.New("symbiont: cannot clone an app that has already been run"))
+	}
+	specs := a.hostedSpecs()
+	c.runnableSpecsList = make([]runnableSpecs, 0, len(specs))
+	for _, rs := range specs {
+		if d, ok := rs.executor.(*defaultReadyChecker); ok {
+			fresh := &defaultReadyChecker{runable: d.runable, probe: d.probe}
+			rs.executor = fresh
+			rs.readyChecker = fresh
+		}
+		c.runnableSpecsList = append(c.runnableSpecsList, rs)
+	}
+	return c
+}
@@ -0,0 +1,78 @@
+package symbiont
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont/depend"
+)
+
+func TestApp_Clone(t *testing.T) {
+	tests := map[string]struct {
+		build       func(base *App) *App
+		runBase     bool
+		expectedLog []string
+		expectedErr string
+	}{
+		"clone-runs-base-components": {
+			build:       func(base *App) *App { return base.Clone() },
+			expectedLog: []string{"run-a", "init"},
+		},
+		"appending-to-clone-leaves-base-unchanged": {
+			build: func(base *App) *App {
+				return base.Clone().Host(&runCloser{name: "run-b", log: base.initializers[0].(*recCloser).log})
+			},
+			expectedLog: []string{"run-b", "run-a", "init"},
+		},
+		"clone-after-run-fails": {
+			build:       func(base *App) *App { return base.Clone() },
+			runBase:     true,
+			expectedLog: []string{},
+			expectedErr: "symbiont: cannot clone an app that has already been run",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			depend.ClearContainer()
+			log := []string{}
+			base := NewApp().
+				Initialize(&recCloser{name: "init", log: &log}).
+				Host(&runCloser{name: "run-a", log: &log})
+			if tt.runBase {
+				if err := base.RunWithContext(context.Background()); err != nil {
+					t.Fatalf("expected base to run, got %v", err)
+				}
+				log = log[:0]
+			}
+
+			clone := tt.build(base)
+			err := clone.RunWithContext(context.Background())
+			if tt.expectedErr == "" && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if tt.expectedErr != "" && (err == nil || err.Error() != tt.expectedErr) {
+				t.Fatalf("expected error %q, got %v", tt.expectedErr, err)
+			}
+			if !reflect.DeepEqual(tt.expectedLog, log) {
+				t.Fatalf("expected log %v, got %v", tt.expectedLog, log)
+			}
+			if len(base.runnableSpecsList) != 1 {
+				t.Fatalf("expected base to keep 1 runnable, got %d", len(base.runnableSpecsList))
+			}
+		})
+	}
+}
+
+func TestApp_Clone_FreshReadiness(t *testing.T) {
+	base := NewApp().Host(&runCloser{name: "run-a", log: &[]string{}})
+	clone := base.Clone()
+
+	if base.runnableSpecsList[0].executor == clone.runnableSpecsList[0].executor {
+		t.Fatal("expected the clone to have its own ready checker")
+	}
+	if clone.runnableSpecsList[0].original != base.runnableSpecsList[0].original {
+		t.Fatal("expected the clone to share the hosted runnable")
+	}
+}
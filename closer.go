@@ -0,0 +1,104 @@
+package symbiont
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// closerRegistryKey is the context key under which the current initializer's closer registry is stored.
+type closerRegistryKey struct{}
+
+// closerRegistry collects cleanup functions registered while an initializer runs.
+type closerRegistry struct {
+	mu     sync.Mutex
+	fns    []closerFunc
+	closed bool
+}
+
+// RegisterCloser registers fn to run during shutdown, for resources opened inside Initialize
+// that are not attached to a Closer. It must be called with the context passed to Initialize.
+// Registered functions run in LIFO order together with Closer components: they run after
+// closers of later components and before the initializer's own Close, if it has one.
+// Returns an error when ctx does not belong to a running Initialize call.
+func RegisterCloser(ctx context.Context, fn func()) error {
+	if fn == nil {
+		return errors.New("symbiont: closer function must not be nil")
+	}
+	reg, ok := ctx.Value(closerRegistryKey{}).(*closerRegistry)
+	if !ok {
+		return errors.New("symbiont: RegisterCloser must be called with the context passed to Initialize")
+	}
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	if reg.closed {
+		return errors.New("symbiont: RegisterCloser must be called before Initialize returns")
+	}
+	reg.fns = append(reg.fns, func(context.Context) error {
+		fn()
+		return nil
+	})
+	return nil
+}
+
+// withCloserRegistry returns a context carrying a fresh closer registry for one initializer.
+func withCloserRegistry(ctx context.Context) (context.Context, *closerRegistry) {
+	reg := &closerRegistry{}
+	return context.WithValue(ctx, closerRegistryKey{}, reg), reg
+}
+
+// componentCloser returns the shutdown function of a component implementing ContextCloser or Closer.
+// ContextCloser errors are wrapped with the component and PhaseShutdown.
+func componentCloser(component any) (closerFunc, bool) {
+	switch c := component.(type) {
+	case ContextCloser:
+		return func(ctx context.Context) error {
+			if err := c.Close(ctx); err != nil {
+				return newPhaseError(err, component, PhaseShutdown)
+			}
+			return nil
+		}, true
+	case Closer:
+		return func(context.Context) error {
+			c.Close()
+			return nil
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+// registeredCloser is a shutdown function with the name and close priority of the component it belongs to.
+type registeredCloser struct {
+	fn        closerFunc
+	component string
+	priority  int
+}
+
+// closePriority returns the close priority of a component, or 0 if it does not implement ClosePrioritizer.
+func closePriority(component any) int {
+	if p, ok := component.(ClosePrioritizer); ok {
+		return p.ClosePriority()
+	}
+	return 0
+}
+
+// closersOf pairs each closer with the name and close priority of component.
+func closersOf(component any, fns ...closerFunc) []registeredCloser {
+	name, priority := componentName(component), closePriority(component)
+	out := make([]registeredCloser, 0, len(fns))
+	for _, fn := range fns {
+		out = append(out, registeredCloser{fn: fn, component: name, priority: priority})
+	}
+	return out
+}
+
+// drain closes the registry to further registrations and returns the registered closers in order.
+func (r *closerRegistry) drain() []closerFunc {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.closed = true
+	fns := r.fns
+	r.fns = nil
+	return fns
+}
@@ -0,0 +1,258 @@
+package symbiont
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// registeringInitializer registers cleanup functions from Initialize and optionally fails afterwards.
+type registeringInitializer struct {
+	name    string
+	fns     []string
+	log     *[]string
+	willErr bool
+	ctx     context.Context
+}
+
+func (r *registeringInitializer) Initialize(ctx context.Context) (context.Context, error) {
+	for _, fn := range r.fns {
+		if err := RegisterCloser(ctx, func() { *r.log = append(*r.log, fn) }); err != nil {
+			return ctx, err
+		}
+	}
+	r.ctx = ctx
+	if r.willErr {
+		return ctx, errors.New("init failed")
+	}
+	return ctx, nil
+}
+
+// closingRegisteringInitializer is a registeringInitializer that is also a Closer.
+type closingRegisteringInitializer struct {
+	registeringInitializer
+}
+
+func (c *closingRegisteringInitializer) Close() { *c.log = append(*c.log, c.name) }
+
+func TestRegisterCloser(t *testing.T) {
+	tests := map[string]struct {
+		build     func(log *[]string) *App
+		wantOrder []string
+		wantErr   bool
+	}{
+		"interleaves-with-closer-components": {
+			build: func(log *[]string) *App {
+				return NewApp().
+					Initialize(
+						&closingRegisteringInitializer{registeringInitializer{name: "A", fns: []string{"a1", "a2"}, log: log}},
+						&registeringInitializer{name: "B", fns: []string{"b1"}, log: log},
+					).
+					Host(&runCloser{name: "R", log: log})
+			},
+			wantOrder: []string{"R", "b1", "a2", "a1", "A"},
+		},
+		"runs-registered-closers-when-initializer-fails": {
+			build: func(log *[]string) *App {
+				return NewApp().Initialize(
+					&registeringInitializer{name: "A", fns: []string{"a1"}, log: log},
+					&registeringInitializer{name: "B", fns: []string{"b1"}, log: log, willErr: true},
+				)
+			},
+			wantOrder: []string{"b1", "a1"},
+			wantErr:   true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var log []string
+			err := tt.build(&log).RunWithContext(context.Background())
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if !slices.Equal(tt.wantOrder, log) {
+				t.Fatalf("expected close order %v, got %v", tt.wantOrder, log)
+			}
+		})
+	}
+}
+
+func TestRegisterCloser_OutsideInitialize(t *testing.T) {
+	if err := RegisterCloser(context.Background(), func() {}); err == nil {
+		t.Fatal("expected error registering without an Initialize context")
+	}
+
+	var log []string
+	init := &registeringInitializer{name: "A", log: &log}
+	if err := NewApp().Initialize(init).RunWithContext(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	err := RegisterCloser(init.ctx, func() {})
+	if err == nil || err.Error() != "symbiont: RegisterCloser must be called before Initialize returns" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+// ctxCloserRunnable implements ContextCloser and records the context it was closed with.
+type ctxCloserRunnable struct {
+	name     string
+	log      *[]string
+	closeErr error
+	gotErr   error
+	deadline time.Duration
+}
+
+func (c *ctxCloserRunnable) Run(context.Context) error { return nil }
+
+func (c *ctxCloserRunnable) Close(ctx context.Context) error {
+	*c.log = append(*c.log, c.name)
+	c.gotErr = ctx.Err()
+	if d, ok := ctx.Deadline(); ok {
+		c.deadline = time.Until(d)
+	}
+	return c.closeErr
+}
+
+func TestApp_ContextCloser(t *testing.T) {
+	tests := map[string]struct {
+		closeErr  error
+		runErr    bool
+		wantErrs  []string
+		wantOrder []string
+	}{
+		"closes-with-live-bounded-context": {
+			wantOrder: []string{"C", "B", "A"},
+		},
+		"close-error-is-returned": {
+			closeErr:  errors.New("flush failed"),
+			wantErrs:  []string{"flush failed"},
+			wantOrder: []string{"C", "B", "A"},
+		},
+		"close-error-is-joined-with-run-error": {
+			closeErr:  errors.New("flush failed"),
+			runErr:    true,
+			wantErrs:  []string{"run error", "flush failed"},
+			wantOrder: []string{"C", "B", "A"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var log []string
+			ctxCloser := &ctxCloserRunnable{name: "B", log: &log, closeErr: tt.closeErr}
+			err := NewApp().
+				WithShutdownTimeout(time.Minute).
+				Initialize(&closingRegisteringInitializer{registeringInitializer{name: "A", log: &log}}).
+				Host(ctxCloser, &runCloser{name: "C", log: &log, willErr: tt.runErr}).
+				RunWithContext(context.Background())
+
+			if !slices.Equal(log, tt.wantOrder) {
+				t.Fatalf("expected close order %v, got %v", tt.wantOrder, log)
+			}
+			if ctxCloser.gotErr != nil {
+				t.Fatalf("expected a live shutdown context, got %v", ctxCloser.gotErr)
+			}
+			if ctxCloser.deadline <= 0 || ctxCloser.deadline > time.Minute {
+				t.Fatalf("expected a deadline within the shutdown timeout, got %v", ctxCloser.deadline)
+			}
+			if len(tt.wantErrs) == 0 {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return
+			}
+			for _, want := range tt.wantErrs {
+				if err == nil || !strings.Contains(err.Error(), want) {
+					t.Fatalf("expected error to contain %q, got %v", want, err)
+				}
+			}
+			var se Error
+			if !tt.runErr && (!errors.As(err, &se) || se.Phase != PhaseShutdown) {
+				t.Fatalf("expected a shutdown phase error, got %v", err)
+			}
+		})
+	}
+}
+
+// priorityCloser is a recCloser with a close priority.
+type priorityCloser struct {
+	recCloser
+	priority int
+}
+
+func (p *priorityCloser) ClosePriority() int { return p.priority }
+
+func TestApp_ClosePriority(t *testing.T) {
+	tests := map[string]struct {
+		priorities map[string]int
+		wantOrder  []string
+	}{
+		"no-priorities-is-lifo": {
+			wantOrder: []string{"D", "C", "fn", "B", "A"},
+		},
+		"higher-priority-closes-first": {
+			priorities: map[string]int{"A": 10},
+			wantOrder:  []string{"A", "D", "C", "fn", "B"},
+		},
+		"equal-priorities-stay-lifo": {
+			priorities: map[string]int{"A": 5, "C": 5},
+			wantOrder:  []string{"C", "A", "D", "fn", "B"},
+		},
+		"negative-priority-closes-last": {
+			priorities: map[string]int{"D": -1},
+			wantOrder:  []string{"C", "fn", "B", "A", "D"},
+		},
+		"registered-closers-share-initializer-priority": {
+			priorities: map[string]int{"B": 1},
+			wantOrder:  []string{"fn", "B", "D", "C", "A"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var log []string
+			component := func(name string) *priorityCloser {
+				return &priorityCloser{recCloser: recCloser{name: name, log: &log}, priority: tt.priorities[name]}
+			}
+			b := &prioritizedRegisteringInitializer{
+				closingRegisteringInitializer: closingRegisteringInitializer{
+					registeringInitializer{name: "B", fns: []string{"fn"}, log: &log},
+				},
+				priority: tt.priorities["B"],
+			}
+			err := NewApp().
+				Initialize(component("A"), b).
+				Host(&runCloserWithPriority{runCloser: runCloser{name: "C", log: &[]string{}}, closeLog: &log, priority: tt.priorities["C"]}).
+				Host(&runCloserWithPriority{runCloser: runCloser{name: "D", log: &[]string{}}, closeLog: &log, priority: tt.priorities["D"]}).
+				RunWithContext(context.Background())
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if !slices.Equal(log, tt.wantOrder) {
+				t.Fatalf("expected close order %v, got %v", tt.wantOrder, log)
+			}
+		})
+	}
+}
+
+// prioritizedRegisteringInitializer is a closingRegisteringInitializer with a close priority.
+type prioritizedRegisteringInitializer struct {
+	closingRegisteringInitializer
+	priority int
+}
+
+func (p *prioritizedRegisteringInitializer) ClosePriority() int { return p.priority }
+
+// runCloserWithPriority is a runnable that records its Close in closeLog and has a close priority.
+type runCloserWithPriority struct {
+	runCloser
+	closeLog *[]string
+	priority int
+}
+
+func (r *runCloserWithPriority) Close()             { *r.closeLog = append(*r.closeLog, r.name) }
+func (r *runCloserWithPriority) ClosePriority() int { return r.priority }
@@ -0,0 +1,137 @@
+// Package awsssm provides a config.Provider backed by AWS Systems Manager Parameter Store.
+//
+// The package does not import the AWS SDK. Provider talks to Parameter Store through the
+// small Client interface, which an application adapts from its own SSM client:
+//
+//	client := awsssm.ClientFunc(func(ctx context.Context, name string, decrypt bool) (string, error) {
+//		out, err := ssmClient.GetParameter(ctx, &ssm.GetParameterInput{
+//			Name:           aws.String(name),
+//			WithDecryption: aws.Bool(decrypt),
+//		})
+//		var notFound *types.ParameterNotFound
+//		if errors.As(err, &notFound) {
+//			return "", awsssm.ErrParameterNotFound
+//		}
+//		if err != nil {
+//			return "", err
+//		}
+//		return aws.ToString(out.Parameter.Value), nil
+//	})
+package awsssm
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont/config"
+)
+
+// Source is the provider source reported to introspection for values read from Parameter Store.
+const Source = "aws-ssm"
+
+// ErrParameterNotFound is returned by a Client when a parameter does not exist.
+var ErrParameterNotFound = errors.New("parameter not found")
+
+// Client retrieves a single parameter value from Parameter Store.
+// decrypt requests the plaintext of SecureString parameters.
+// Implementations return ErrParameterNotFound, possibly wrapped, for missing parameters.
+type Client interface {
+	GetParameter(ctx context.Context, name string, decrypt bool) (string, error)
+}
+
+// ClientFunc adapts a function to the Client interface.
+type ClientFunc func(ctx context.Context, name string, decrypt bool) (string, error)
+
+// GetParameter calls f.
+func (f ClientFunc) GetParameter(ctx context.Context, name string, decrypt bool) (string, error) {
+	return f(ctx, name, decrypt)
+}
+
+// Provider retrieves configuration values from AWS Systems Manager Parameter Store.
+// It implements config.Provider and config.ProviderWithSource.
+type Provider struct {
+	client  Client
+	prefix  string
+	decrypt bool
+}
+
+// NewProvider creates a provider that reads parameters through client.
+func NewProvider(client Client) *Provider {
+	return &Provider{client: client}
+}
+
+// WithPathPrefix reads every key under a parameter hierarchy, so the key DB_HOST with
+// the prefix /myapp/prod is read from the parameter /myapp/prod/DB_HOST.
+func (p *Provider) WithPathPrefix(prefix string) *Provider {
+	p.prefix = prefix
+	return p
+}
+
+// WithDecryption requests the plaintext of SecureString parameters.
+// Without it, SecureString parameters are returned encrypted, as Parameter Store does by default.
+func (p *Provider) WithDecryption(enabled bool) *Provider {
+	p.decrypt = enabled
+	return p
+}
+
+// Get retrieves the parameter value for the given key.
+func (p *Provider) Get(ctx context.Context, name string) (string, error) {
+	value, _, err := p.GetWithSource(ctx, name)
+	return value, err
+}
+
+// GetWithSource retrieves the parameter value for the given key and reports "aws-ssm" as its source.
+func (p *Provider) GetWithSource(ctx context.Context, name string) (string, string, error) {
+	param := p.parameterName(name)
+	value, err := p.client.GetParameter(ctx, param, p.decrypt)
+	if err != nil {
+		if errors.Is(err, ErrParameterNotFound) {
+			return "", "", fmt.Errorf("parameter '%s': %w: %w", param, config.ErrKeyNotFound, err)
+		}
+		return "", "", fmt.Errorf("error reading parameter '%s': %w", param, err)
+	}
+	return value, Source, nil
+}
+
+// parameterName joins the path prefix and the key.
+func (p *Provider) parameterName(name string) string {
+	if p.prefix == "" {
+		return name
+	}
+	return strings.TrimSuffix(p.prefix, "/") + "/" + strings.TrimPrefix(name, "/")
+}
+
+// Parameter is a parameter stored by MemoryClient.
+type Parameter struct {
+	Value string
+	// Secure marks a SecureString parameter.
+	Secure bool
+}
+
+// MemoryClient is an in-memory Client for tests.
+// SecureString parameters read without decryption are returned base64 encoded,
+// standing in for the ciphertext Parameter Store would return.
+type MemoryClient struct {
+	params map[string]Parameter
+}
+
+// NewMemoryClient creates a client serving a copy of params, keyed by full parameter name.
+func NewMemoryClient(params map[string]Parameter) *MemoryClient {
+	return &MemoryClient{params: maps.Clone(params)}
+}
+
+// GetParameter retrieves the parameter stored under name.
+func (c *MemoryClient) GetParameter(_ context.Context, name string, decrypt bool) (string, error) {
+	param, ok := c.params[name]
+	if !ok {
+		return "", ErrParameterNotFound
+	}
+	if param.Secure && !decrypt {
+		return base64.StdEncoding.EncodeToString([]byte(param.Value)), nil
+	}
+	return param.Value, nil
+}
@@ -0,0 +1,109 @@
+package awsssm
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont/config"
+)
+
+func TestProvider_GetWithSource(t *testing.T) {
+	client := NewMemoryClient(map[string]Parameter{
+		"DB_HOST":              {Value: "localhost"},
+		"/myapp/prod/DB_HOST":  {Value: "db.internal"},
+		"/myapp/prod/PASSWORD": {Value: "s3cret", Secure: true},
+	})
+
+	tests := map[string]struct {
+		provider     *Provider
+		key          string
+		wantValue    string
+		wantErr      string
+		wantNotFound bool
+	}{
+		"found-without-prefix": {
+			provider:  NewProvider(client),
+			key:       "DB_HOST",
+			wantValue: "localhost",
+		},
+		"found-with-prefix": {
+			provider:  NewProvider(client).WithPathPrefix("/myapp/prod"),
+			key:       "DB_HOST",
+			wantValue: "db.internal",
+		},
+		"prefix-with-trailing-slash": {
+			provider:  NewProvider(client).WithPathPrefix("/myapp/prod/"),
+			key:       "DB_HOST",
+			wantValue: "db.internal",
+		},
+		"secure-string-decrypted": {
+			provider:  NewProvider(client).WithPathPrefix("/myapp/prod").WithDecryption(true),
+			key:       "PASSWORD",
+			wantValue: "s3cret",
+		},
+		"secure-string-encrypted": {
+			provider:  NewProvider(client).WithPathPrefix("/myapp/prod"),
+			key:       "PASSWORD",
+			wantValue: "czNjcmV0",
+		},
+		"missing-parameter": {
+			provider:     NewProvider(client).WithPathPrefix("/myapp/prod"),
+			key:          "PORT",
+			wantErr:      "parameter '/myapp/prod/PORT': key not found: parameter not found",
+			wantNotFound: true,
+		},
+		"client-error": {
+			provider: NewProvider(ClientFunc(func(context.Context, string, bool) (string, error) {
+				return "", errors.New("throttled")
+			})),
+			key:     "DB_HOST",
+			wantErr: "error reading parameter 'DB_HOST': throttled",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			value, source, err := tt.provider.GetWithSource(context.Background(), tt.key)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+				if errors.Is(err, config.ErrKeyNotFound) != tt.wantNotFound {
+					t.Fatalf("expected errors.Is(err, config.ErrKeyNotFound) to be %v, got %v", tt.wantNotFound, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if value != tt.wantValue || source != Source {
+				t.Fatalf("expected %q from %q, got %q from %q", tt.wantValue, Source, value, source)
+			}
+		})
+	}
+}
+
+func TestProvider_Introspection(t *testing.T) {
+	defer config.ResetGlobalProvider()
+	config.SetGlobalProvider(NewProvider(NewMemoryClient(map[string]Parameter{
+		"/myapp/PORT": {Value: "8080"},
+	})).WithPathPrefix("/myapp"))
+
+	port, err := config.Get[int](context.Background(), "PORT")
+	if err != nil || port != 8080 {
+		t.Fatalf("expected 8080, got %v, %v", port, err)
+	}
+	if got := config.GetWithDefault(context.Background(), "HOST", "localhost"); got != "localhost" {
+		t.Fatalf("expected default for missing parameter, got %q", got)
+	}
+	var sources []string
+	for _, access := range config.IntrospectConfigAccesses() {
+		if access.Key == "PORT" {
+			sources = append(sources, access.Provider)
+		}
+	}
+	if len(sources) != 1 || sources[0] != Source {
+		t.Fatalf("expected PORT to be read from %q, got %v", Source, sources)
+	}
+}
@@ -0,0 +1,106 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// BatchProvider is an optional interface for providers that can fetch several keys in one round trip.
+// GetMany uses it instead of one Get call per key.
+type BatchProvider interface {
+	// GetMany retrieves the values of the given keys. Keys that do not exist are left out of the
+	// returned map; an error means the whole batch failed.
+	GetMany(ctx context.Context, keys []string) (map[string]string, error)
+}
+
+// GetMany retrieves the raw values of several configuration keys, keyed by the requested names.
+// Providers implementing BatchProvider fetch all uncached keys in one call; others are read
+// sequentially with Get. Each key is recorded as a config access.
+// Returns an error listing every key that could not be read.
+//
+//	values, err := config.GetMany(ctx, "DB_HOST", "DB_PORT", "DB_NAME")
+func GetMany(ctx context.Context, keys ...string) (map[string]string, error) {
+	prefix := Prefix(ctx)
+	prefixed := make([]string, len(keys))
+	for i, k := range keys {
+		prefixed[i] = prefix + k
+	}
+	values, errs := globalProvider.getMany(ctx, prefixed, nil, 3)
+	if len(errs) > 0 {
+		for i, err := range errs {
+			errs[i] = fmt.Errorf("config: %w", err)
+		}
+		return nil, errors.Join(errs...)
+	}
+	out := make(map[string]string, len(keys))
+	for i, k := range keys {
+		out[k] = values[prefixed[i]]
+	}
+	return out, nil
+}
+
+// getMany retrieves several keys, serving cached keys first and fetching the rest through the
+// provider's batch support when available. Errors are returned per key.
+func (i *providerInspector) getMany(ctx context.Context, keys []string, componentType reflect.Type, level int) (map[string]string, []error) {
+	values := make(map[string]string, len(keys))
+	var (
+		missing []string
+		errs    []error
+	)
+	for _, key := range keys {
+		val, source, ok := i.getFromCache(key)
+		if !ok {
+			missing = append(missing, key)
+			continue
+		}
+		// A key cached after a failed lookup with a default is known to be absent.
+		i.mu.Lock()
+		absentErr, isAbsent := i.absent[key]
+		i.mu.Unlock()
+		if isAbsent {
+			errs = append(errs, absentErr)
+			continue
+		}
+		i.recordKeyAccess(key, val, source, false, componentType, level)
+		values[key] = val
+	}
+	if len(missing) == 0 {
+		return values, errs
+	}
+
+	// The provider and its name are read together, so a concurrent SetGlobalProvider cannot pair them wrongly.
+	provider, source, _ := i.providerFor("")
+	bp, ok := provider.(BatchProvider)
+	if !ok {
+		for _, key := range missing {
+			val, err := i.get(ctx, "", key, false, componentType, level+1)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			values[key] = val
+		}
+		return values, errs
+	}
+
+	fetched, err := bp.GetMany(ctx, missing)
+	if err != nil {
+		return nil, append(errs, err)
+	}
+	for _, key := range missing {
+		val, found := fetched[key]
+		if !found {
+			errs = append(errs, fmt.Errorf("%w: '%s'", ErrKeyNotFound, key))
+			continue
+		}
+		i.recordKeyAccess(key, val, source, false, componentType, level)
+		i.mu.Lock()
+		i.cache[key] = val
+		i.sources[key] = source
+		i.mu.Unlock()
+		values[key] = val
+	}
+	return values, errs
+}
@@ -0,0 +1,161 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// batchStubProvider serves values from a map and counts Get and GetMany calls.
+type batchStubProvider struct {
+	values  map[string]string
+	err     error
+	gets    int
+	batches [][]string
+}
+
+func (p *batchStubProvider) Get(_ context.Context, name string) (string, error) {
+	p.gets++
+	value, ok := p.values[name]
+	if !ok {
+		return "", errors.New("key not found")
+	}
+	return value, nil
+}
+
+func (p *batchStubProvider) GetMany(_ context.Context, keys []string) (map[string]string, error) {
+	p.batches = append(p.batches, keys)
+	if p.err != nil {
+		return nil, p.err
+	}
+	out := make(map[string]string)
+	for _, k := range keys {
+		if v, ok := p.values[k]; ok {
+			out[k] = v
+		}
+	}
+	return out, nil
+}
+
+func TestGetMany(t *testing.T) {
+	tests := map[string]struct {
+		provider    func() Provider
+		ctx         context.Context
+		keys        []string
+		expected    map[string]string
+		expectedErr string
+	}{
+		"sequential-fallback": {
+			provider: func() Provider {
+				stub := &stubProvider{}
+				stub.set("HOST", "localhost", nil)
+				stub.set("PORT", "8080", nil)
+				return stub
+			},
+			keys:     []string{"HOST", "PORT"},
+			expected: map[string]string{"HOST": "localhost", "PORT": "8080"},
+		},
+		"sequential-fallback-missing-keys": {
+			provider: func() Provider {
+				stub := &stubProvider{}
+				stub.set("HOST", "localhost", nil)
+				stub.set("PORT", "", errors.New("key 'PORT' was not found"))
+				return stub
+			},
+			keys:        []string{"HOST", "PORT", "USER"},
+			expectedErr: "config: key 'PORT' was not found\nconfig: unexpected config lookup for key \"USER\"",
+		},
+		"batch": {
+			provider: func() Provider {
+				return &batchStubProvider{values: map[string]string{"HOST": "localhost", "PORT": "8080"}}
+			},
+			keys:     []string{"HOST", "PORT"},
+			expected: map[string]string{"HOST": "localhost", "PORT": "8080"},
+		},
+		"batch-missing-keys": {
+			provider: func() Provider {
+				return &batchStubProvider{values: map[string]string{"HOST": "localhost"}}
+			},
+			keys:        []string{"HOST", "PORT", "USER"},
+			expectedErr: "config: key not found: 'PORT'\nconfig: key not found: 'USER'",
+		},
+		"batch-failure": {
+			provider: func() Provider {
+				return &batchStubProvider{err: errors.New("throttled")}
+			},
+			keys:        []string{"HOST"},
+			expectedErr: "config: throttled",
+		},
+		"prefixed": {
+			provider: func() Provider {
+				return &batchStubProvider{values: map[string]string{"ADMIN_PORT": "9090"}}
+			},
+			ctx:      WithPrefix(context.Background(), "ADMIN_"),
+			keys:     []string{"PORT"},
+			expected: map[string]string{"PORT": "9090"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			SetGlobalProvider(tt.provider())
+			defer ResetGlobalProvider()
+
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			got, err := GetMany(ctx, tt.keys...)
+			assertErrorMessage(t, err, tt.expectedErr)
+			if !maps.Equal(tt.expected, got) {
+				t.Fatalf("expected %v, got %v", tt.expected, got)
+			}
+			for _, access := range IntrospectConfigAccesses() {
+				if !strings.HasPrefix(access.Caller.Func, "config.TestGetMany") {
+					t.Fatalf("expected access to %q attributed to the test, got %q", access.Key, access.Caller.Func)
+				}
+			}
+		})
+	}
+}
+
+func TestGetMany_BatchesUncachedKeys(t *testing.T) {
+	provider := &batchStubProvider{values: map[string]string{"HOST": "localhost", "PORT": "8080", "USER": "app"}}
+	SetGlobalProvider(provider)
+	defer ResetGlobalProvider()
+	ctx := context.Background()
+
+	if host, err := Get[string](ctx, "HOST"); err != nil || host != "localhost" {
+		t.Fatalf("expected HOST to be read, got %q, %v", host, err)
+	}
+	if got := GetWithDefault(ctx, "MISSING", "fallback"); got != "fallback" {
+		t.Fatalf("expected default for MISSING, got %q", got)
+	}
+	if _, err := GetMany(ctx, "HOST", "PORT", "USER"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := GetMany(ctx, "MISSING"); err == nil {
+		t.Fatal("expected a key cached as absent to be reported as not found")
+	}
+
+	if provider.gets != 2 {
+		t.Fatalf("expected only Get and GetWithDefault to call Get, got %d calls", provider.gets)
+	}
+	if want := [][]string{{"PORT", "USER"}}; !slices.EqualFunc(want, provider.batches, slices.Equal) {
+		t.Fatalf("expected uncached keys to be fetched in one batch %v, got %v", want, provider.batches)
+	}
+
+	var keys []string
+	for _, access := range IntrospectConfigAccesses() {
+		if access.Caller.Func != "config.TestGetMany_BatchesUncachedKeys" {
+			t.Fatalf("expected access attributed to the test, got %q", access.Caller.Func)
+		}
+		keys = append(keys, access.Key)
+	}
+	if want := []string{"HOST", "HOST", "MISSING", "PORT", "USER"}; !slices.Equal(want, keys) {
+		t.Fatalf("expected accesses %v, got %v", want, keys)
+	}
+}
@@ -0,0 +1,91 @@
+package config
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/cleitonmarx/symbiont/internal/reflectx"
+	"golang.org/x/sync/singleflight"
+)
+
+// cachedValue is a memoized provider value with its source and expiry.
+type cachedValue struct {
+	value   string
+	source  string
+	expires time.Time
+}
+
+// CachedProvider decorates a Provider, memoizing successful lookups per key for a TTL.
+// Useful for remote providers such as secret managers where each lookup is expensive.
+// Concurrent lookups for the same uncached key share a single upstream call.
+// Failed lookups are not cached.
+type CachedProvider struct {
+	provider     Provider
+	providerName string
+	ttl          time.Duration
+	now          func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]cachedValue
+	group   singleflight.Group
+}
+
+// NewCachedProvider creates a provider that caches values from p for ttl.
+// A ttl of zero or less disables caching, passing every lookup through to p.
+func NewCachedProvider(p Provider, ttl time.Duration) *CachedProvider {
+	return &CachedProvider{
+		provider:     p,
+		providerName: reflectx.TypeNameOf(p),
+		ttl:          ttl,
+		now:          time.Now,
+		entries:      make(map[string]cachedValue),
+	}
+}
+
+// Get retrieves a configuration value, from the cache when it has not expired.
+func (p *CachedProvider) Get(ctx context.Context, name string) (string, error) {
+	value, _, err := p.GetWithSource(ctx, name)
+	return value, err
+}
+
+// GetWithSource retrieves a configuration value and reports the source of the wrapped provider.
+// Cached values keep the source reported when they were fetched.
+func (p *CachedProvider) GetWithSource(ctx context.Context, name string) (string, string, error) {
+	if p.ttl <= 0 {
+		return p.fetch(ctx, name)
+	}
+
+	p.mu.Lock()
+	entry, ok := p.entries[name]
+	p.mu.Unlock()
+	if ok && p.now().Before(entry.expires) {
+		return entry.value, entry.source, nil
+	}
+
+	result, err, _ := p.group.Do(name, func() (any, error) {
+		value, source, err := p.fetch(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		entry := cachedValue{value: value, source: source, expires: p.now().Add(p.ttl)}
+		p.mu.Lock()
+		p.entries[name] = entry
+		p.mu.Unlock()
+		return entry, nil
+	})
+	if err != nil {
+		return "", "", err
+	}
+	entry = result.(cachedValue)
+	return entry.value, entry.source, nil
+}
+
+// fetch retrieves a value from the wrapped provider, passing through its source when reported.
+func (p *CachedProvider) fetch(ctx context.Context, name string) (string, string, error) {
+	if sp, ok := p.provider.(ProviderWithSource); ok {
+		return sp.GetWithSource(ctx, name)
+	}
+	value, err := p.provider.Get(ctx, name)
+	return value, p.providerName, err
+}
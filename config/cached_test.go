@@ -0,0 +1,128 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingProvider counts upstream lookups and can block them until released.
+type countingProvider struct {
+	calls   atomic.Int32
+	release chan struct{}
+	err     error
+}
+
+func (c *countingProvider) Get(_ context.Context, name string) (string, error) {
+	c.calls.Add(1)
+	if c.release != nil {
+		<-c.release
+	}
+	if c.err != nil {
+		return "", c.err
+	}
+	return "value-" + name, nil
+}
+
+func TestCachedProvider_GetWithSource(t *testing.T) {
+	tests := map[string]struct {
+		ttl        time.Duration
+		upstream   *countingProvider
+		advance    time.Duration
+		wantCalls  int32
+		wantValue  string
+		wantSource string
+		wantErr    string
+	}{
+		"cached-within-ttl": {
+			ttl:        time.Minute,
+			upstream:   &countingProvider{},
+			advance:    30 * time.Second,
+			wantCalls:  1,
+			wantValue:  "value-KEY",
+			wantSource: "*config.countingProvider",
+		},
+		"refetched-after-expiry": {
+			ttl:        time.Minute,
+			upstream:   &countingProvider{},
+			advance:    2 * time.Minute,
+			wantCalls:  2,
+			wantValue:  "value-KEY",
+			wantSource: "*config.countingProvider",
+		},
+		"zero-ttl-disables-caching": {
+			upstream:   &countingProvider{},
+			wantCalls:  2,
+			wantValue:  "value-KEY",
+			wantSource: "*config.countingProvider",
+		},
+		"errors-are-not-cached": {
+			ttl:       time.Minute,
+			upstream:  &countingProvider{err: errors.New("vault unavailable")},
+			wantCalls: 2,
+			wantErr:   "vault unavailable",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			now := time.Now()
+			p := NewCachedProvider(tt.upstream, tt.ttl)
+			p.now = func() time.Time { return now }
+
+			_, _, _ = p.GetWithSource(context.Background(), "KEY")
+			now = now.Add(tt.advance)
+			value, source, err := p.GetWithSource(context.Background(), "KEY")
+
+			assertErrorMessage(t, err, tt.wantErr)
+			if value != tt.wantValue || source != tt.wantSource {
+				t.Fatalf("expected (%q, %q), got (%q, %q)", tt.wantValue, tt.wantSource, value, source)
+			}
+			if got := tt.upstream.calls.Load(); got != tt.wantCalls {
+				t.Fatalf("expected %d upstream calls, got %d", tt.wantCalls, got)
+			}
+		})
+	}
+}
+
+func TestCachedProvider_PassesThroughSource(t *testing.T) {
+	t.Setenv("CACHED_KEY", "from-env")
+	p := NewCachedProvider(NewCompositeProvider(NewEnvVarProvider()), time.Minute)
+	value, source, err := p.GetWithSource(context.Background(), "CACHED_KEY")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if value != "from-env" || source != "config.EnvVarProvider" {
+		t.Fatalf("expected (%q, %q), got (%q, %q)", "from-env", "config.EnvVarProvider", value, source)
+	}
+}
+
+func TestCachedProvider_CoalescesConcurrentLookups(t *testing.T) {
+	upstream := &countingProvider{release: make(chan struct{})}
+	p := NewCachedProvider(upstream, time.Minute)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := p.Get(context.Background(), "KEY"); err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		}()
+	}
+	for upstream.calls.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	// Give the remaining goroutines time to join the in-flight lookup.
+	time.Sleep(20 * time.Millisecond)
+	close(upstream.release)
+	wg.Wait()
+
+	if got := upstream.calls.Load(); got != 1 {
+		t.Fatalf("expected 1 upstream call, got %d", got)
+	}
+}
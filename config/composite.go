@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/cleitonmarx/symbiont/internal/reflectx"
 )
@@ -43,13 +42,29 @@ func (p CompositeProvider) Get(ctx context.Context, name string) (string, error)
 
 // GetWithSource retrieves a configuration value and reports which provider provided it.
 func (p CompositeProvider) GetWithSource(ctx context.Context, name string) (string, string, error) {
-	var errMsgs []string
+	var errs []error
 	for _, provider := range p.providers {
 		value, err := provider.ConfigProvider.Get(ctx, name)
 		if err == nil {
 			return value, provider.Name, nil
 		}
-		errMsgs = append(errMsgs, fmt.Sprintf("%s: %v", provider.Name, err))
+		errs = append(errs, fmt.Errorf("%s: %w", provider.Name, err))
 	}
-	return "", "", errors.New(strings.Join(errMsgs, "\n"))
+	if len(errs) == 0 {
+		return "", "", fmt.Errorf("%w: no providers configured", ErrKeyNotFound)
+	}
+	return "", "", joinLookupErrors(errs)
+}
+
+// joinLookupErrors joins the errors of providers that failed to supply a key, one per line.
+// The result wraps ErrKeyNotFound only when every provider reported the key as not found, so a
+// failing provider in the chain is not mistaken for an absent key.
+func joinLookupErrors(errs []error) error {
+	joined := errors.Join(errs...)
+	for _, err := range errs {
+		if !errors.Is(err, ErrKeyNotFound) {
+			return errors.New(joined.Error())
+		}
+	}
+	return joined
 }
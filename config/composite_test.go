@@ -3,6 +3,7 @@ package config
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 )
 
@@ -65,6 +66,34 @@ func TestCompositeProvider_Get(t *testing.T) {
 	}
 }
 
+func TestCompositeProvider_KeyNotFound(t *testing.T) {
+	tests := map[string]struct {
+		errs         []error
+		wantNotFound bool
+	}{
+		"all-not-found": {
+			errs:         []error{fmt.Errorf("key %w", ErrKeyNotFound), fmt.Errorf("key %w", ErrKeyNotFound)},
+			wantNotFound: true,
+		},
+		"one-provider-failed": {
+			errs: []error{fmt.Errorf("key %w", ErrKeyNotFound), errors.New("connection refused")},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			p1 := testProvider1{stubProvider: &stubProvider{}}
+			p2 := testProvider2{stubProvider: &stubProvider{}}
+			p1.set("key", "", tt.errs[0])
+			p2.set("key", "", tt.errs[1])
+
+			_, err := NewCompositeProvider(p1, p2).Get(context.Background(), "key")
+			if err == nil || errors.Is(err, ErrKeyNotFound) != tt.wantNotFound {
+				t.Fatalf("expected errors.Is(err, ErrKeyNotFound) to be %v, got %v", tt.wantNotFound, err)
+			}
+		})
+	}
+}
+
 func TestCompositeProvider_GetAndReportProvider(t *testing.T) {
 	tests := map[string]struct {
 		setStubs         func(p1 *stubProvider, p2 *stubProvider)
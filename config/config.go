@@ -4,9 +4,14 @@ package config
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"net/url"
 	"reflect"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/cleitonmarx/symbiont/internal/reflectx"
@@ -18,11 +23,19 @@ const (
 	tagName = "config"
 	// defaultTagName is the struct tag key for default values
 	defaultTagName = "default"
+	// layoutTagName is the struct tag key for the time layout of time.Time fields
+	layoutTagName = "layout"
+	// decodeTagName is the struct tag key for the encoding of []byte and string fields
+	decodeTagName = "decode"
+	// providerTagName is the struct tag key naming the provider registered with RegisterNamedProvider
+	providerTagName = "provider"
 )
 
 var (
 	// globalProvider wraps the active configuration provider with introspection capabilities
 	globalProvider *providerInspector
+	// parserMu guards parserRegistry, which may be written by initializers while runnables read config
+	parserMu sync.RWMutex
 	// parserRegistry maps types to their parsing functions for string value conversion
 	parserRegistry map[reflect.Type]func(value string) (any, error)
 )
@@ -33,8 +46,34 @@ func SetGlobalProvider(provider Provider) {
 	globalProvider.setProvider(provider)
 }
 
+// RegisterNamedProvider registers p under name, so config fields tagged provider:"name" read their keys
+// from it instead of the global provider, for example secrets from Vault and other settings from the
+// environment:
+//
+//	config.RegisterNamedProvider("vault", vaultProvider)
+//
+//	type Secrets struct {
+//		APIKey string `config:"API_KEY" provider:"vault"`
+//	}
+//
+// Registering a name again replaces its provider, and a nil provider removes the registration.
+// Introspection reports values read from a named provider under its name, unless the provider
+// reports its own source. ResetGlobalProvider removes all named providers.
+func RegisterNamedProvider(name string, p Provider) error {
+	if name == "" {
+		return errors.New("config: provider name must not be empty")
+	}
+	globalProvider.setNamedProvider(name, p)
+	return nil
+}
+
+// ErrKeyNotFound is wrapped by the errors providers return for keys they have no value for,
+// as opposed to lookups that failed. GetWithDefaultStrict only falls back to its default for it.
+var ErrKeyNotFound = errors.New("key not found")
+
 // Provider retrieves configuration values by key.
 // Implementations can read from environment variables, files, remote services, etc.
+// A missing key should be reported with an error wrapping ErrKeyNotFound.
 type Provider interface {
 	// Get retrieves the configuration value for the given key.
 	Get(ctx context.Context, name string) (string, error)
@@ -45,23 +84,101 @@ type Provider interface {
 type ParseFunc[T any] func(value string) (T, error)
 
 // RegisterParser registers a custom parser for type T.
-// Built-in parsers exist for string, bool, int, int64, float64, and time.Duration.
+// Built-in parsers exist for string, bool, int, int64, float64, time.Duration, time.Time (RFC 3339),
+// and *url.URL (absolute, with a scheme and host).
+// Parsers are usually registered at startup, but registration is safe while configuration is being read.
 func RegisterParser[T any](parser ParseFunc[T]) {
+	parserMu.Lock()
+	defer parserMu.Unlock()
 	parserRegistry[reflect.TypeFor[T]()] = func(value string) (any, error) {
 		return parser(value)
 	}
 }
 
+// fieldParser returns the parser for a struct field. A time.Time field with a layout tag
+// is parsed with that layout instead of the registered RFC 3339 parser, and a []byte or string
+// field with a decode tag is decoded from base64 or hex.
+func fieldParser(structField reflect.StructField) (func(value string) (any, error), error) {
+	if encoding, ok := structField.Tag.Lookup(decodeTagName); ok {
+		return decodeParser(structField, encoding)
+	}
+	if layout, ok := structField.Tag.Lookup(layoutTagName); ok {
+		if structField.Type != reflect.TypeFor[time.Time]() {
+			return nil, fmt.Errorf("config: layout tag on field '%s' requires type 'time.Time', got '%s'", structField.Name, reflectx.GetTypeName(structField.Type))
+		}
+		return func(value string) (any, error) { return time.Parse(layout, value) }, nil
+	}
+	parser, exists := lookupParser(structField.Type)
+	if !exists {
+		return nil, fmt.Errorf("config: parser for type '%s' does not exist", reflectx.GetTypeName(structField.Type))
+	}
+	return parser, nil
+}
+
+// decodeParser returns a parser that decodes values in the given encoding into a []byte or string field.
+// Decode errors report the position of the invalid input rather than the value, which may be a secret.
+func decodeParser(structField reflect.StructField, encoding string) (func(value string) (any, error), error) {
+	var decode func(value string) ([]byte, error)
+	switch encoding {
+	case "base64":
+		decode = base64.StdEncoding.DecodeString
+	case "hex":
+		decode = hex.DecodeString
+	default:
+		return nil, fmt.Errorf("config: unknown decode '%s' on field '%s', expected 'base64' or 'hex'", encoding, structField.Name)
+	}
+
+	asString := structField.Type == reflect.TypeFor[string]()
+	if !asString && structField.Type != reflect.TypeFor[[]byte]() {
+		return nil, fmt.Errorf("config: decode tag on field '%s' requires type '[]byte' or 'string', got '%s'", structField.Name, reflectx.GetTypeName(structField.Type))
+	}
+	return func(value string) (any, error) {
+		data, err := decode(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value: %s", encoding, decodeErrorDetail(err))
+		}
+		if asString {
+			return string(data), nil
+		}
+		return data, nil
+	}, nil
+}
+
+// decodeErrorDetail describes a decode error without echoing the offending input.
+func decodeErrorDetail(err error) string {
+	var corrupt base64.CorruptInputError
+	if errors.As(err, &corrupt) {
+		return fmt.Sprintf("illegal data at input byte %d", int64(corrupt))
+	}
+	var invalid hex.InvalidByteError
+	if errors.As(err, &invalid) {
+		return "illegal character"
+	}
+	if errors.Is(err, hex.ErrLength) {
+		return "odd length"
+	}
+	return err.Error()
+}
+
+// lookupParser returns the parser registered for a type.
+func lookupParser(t reflect.Type) (func(value string) (any, error), bool) {
+	parserMu.RLock()
+	defer parserMu.RUnlock()
+	parser, exists := parserRegistry[t]
+	return parser, exists
+}
+
 // getParsedConfigValue retrieves and parses a configuration value using the active provider.
 func getParsedConfigValue[T any](ctx context.Context, name string, useDefault bool) (T, error) {
 	emptyType := reflectx.EmptyValue[T]()
 	typeOfT := reflect.TypeFor[T]()
-	parser, exist := parserRegistry[typeOfT]
+	parser, exist := lookupParser(typeOfT)
 	if !exist {
 		return emptyType, fmt.Errorf("parser for type '%s' does not exist", reflectx.GetTypeName(typeOfT))
 	}
-	configValue, err := globalProvider.get(ctx, name, useDefault, nil, 4)
-	if err != nil {
+	key := Prefix(ctx) + name
+	configValue, found, err := globalProvider.lookup(ctx, key, useDefault, nil, 4)
+	if !found {
 		return emptyType, err
 	}
 	value, err := parser(configValue)
@@ -71,6 +188,23 @@ func getParsedConfigValue[T any](ctx context.Context, name string, useDefault bo
 	return value.(T), nil
 }
 
+// parseURL parses an absolute URL, such as a service endpoint, requiring a scheme and a host so that
+// values like "localhost:8080" or "/v1" are rejected at load time rather than failing on first use.
+// Errors show the URL with its password redacted.
+func parseURL(value string) (*url.URL, error) {
+	u, err := url.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "" {
+		return nil, fmt.Errorf("url '%s' has no scheme", u.Redacted())
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("url '%s' has no host", u.Redacted())
+	}
+	return u, nil
+}
+
 // Get retrieves and parses a configuration value by key and type.
 // Returns an error if the key is not found or parsing fails.
 func Get[T any](ctx context.Context, name string) (T, error) {
@@ -91,12 +225,40 @@ func GetWithDefault[T any](ctx context.Context, name string, defaultValue T) T {
 	return value
 }
 
+// GetWithDefaultStrict retrieves a configuration value, using the default only when the key is absent.
+// Unlike GetWithDefault, provider failures other than ErrKeyNotFound, parse failures, and missing
+// parsers are returned as errors instead of silently falling back to the default.
+func GetWithDefaultStrict[T any](ctx context.Context, name string, defaultValue T) (T, error) {
+	value, err := getParsedConfigValue[T](ctx, name, true)
+	if err != nil {
+		if errors.Is(err, ErrKeyNotFound) {
+			return defaultValue, nil
+		}
+		return value, fmt.Errorf("config: %w", err)
+	}
+	return value, nil
+}
+
 // LoadStruct injects configuration values into all struct fields tagged with config:"key".
-// Supports default values via the default tag. Returns error if a required key is not found.
+// Supports default values via the default tag, expanding ${VAR} references against the active provider
+// and the OS environment. Returns error if a required key is not found.
 func LoadStruct[T any](ctx context.Context, target *T) error {
 	return reflectx.IterateStructFields(target, loadStructFieldValue(ctx))
 }
 
+// Load allocates a T and populates its config-tagged fields like LoadStruct.
+// On error, the zero value of T is returned.
+//
+//	cfg, err := config.Load[DatabaseConfig](ctx)
+func Load[T any](ctx context.Context) (T, error) {
+	var target T
+	if err := LoadStruct(ctx, &target); err != nil {
+		var zero T
+		return zero, err
+	}
+	return target, nil
+}
+
 // LoadStructFieldValue returns a function that injects a single struct field's configuration value.
 // Used internally during struct field injection; handles tags and default values.
 func LoadStructFieldValue(ctx context.Context) reflectx.StructFieldIteratorFunc {
@@ -110,30 +272,61 @@ func loadStructFieldValue(ctx context.Context) reflectx.StructFieldIteratorFunc
 		if !ok {
 			return nil
 		}
+		configName = Prefix(ctx) + configName
 
 		defaultValue, hasDefault := structField.Tag.Lookup(defaultTagName)
-		parser, exists := parserRegistry[structField.Type]
-		if !exists {
-			return fmt.Errorf("config: parser for type '%s' does not exist", reflectx.GetTypeName(structField.Type))
+		parser, err := fieldParser(structField)
+		if err != nil {
+			return err
+		}
+		encrypted, err := isEncryptedField(structField)
+		if err != nil {
+			return err
+		}
+		providerName := structField.Tag.Get(providerTagName)
+		if providerName != "" && !globalProvider.hasNamedProvider(providerName) {
+			return fmt.Errorf("config: provider '%s' for field '%s' is not registered", providerName, structField.Name)
+		}
+
+		// Literal defaults are validated even when the provider has a value, so a typo'd default
+		// fails during development instead of when the key is first missing.
+		if hasDefault && !defaultVarPattern.MatchString(defaultValue) {
+			if _, parseErr := parser(defaultValue); parseErr != nil {
+				return fmt.Errorf("config: invalid default for field '%s': %s", structField.Name, parseErr)
+			}
 		}
 
 		var (
-			valueStr string
-			err      error
+			valueStr    string
+			usedDefault bool
 		)
 		if hasDefault {
-			valueStr, err = globalProvider.get(ctx, configName, true, targetType, 5)
+			valueStr, err = globalProvider.get(ctx, providerName, configName, true, targetType, 5)
 			if err != nil {
-				valueStr = defaultValue
+				usedDefault = true
+				valueStr, err = expandDefault(ctx, defaultValue)
+				if err != nil {
+					return fmt.Errorf("config: error expanding default for field '%s': %s", structField.Name, err)
+				}
 			}
 		} else {
-			valueStr, err = globalProvider.get(ctx, configName, false, targetType, 5)
+			valueStr, err = globalProvider.get(ctx, providerName, configName, false, targetType, 5)
 			if err != nil {
 				return fmt.Errorf("config: error getting value for field '%s': %s", structField.Name, err)
 			}
 		}
 
+		if encrypted && !usedDefault {
+			valueStr, err = decrypt(ctx, configName, valueStr)
+			if err != nil {
+				return fmt.Errorf("config: error decrypting value for field '%s': %s", structField.Name, err)
+			}
+		}
+
 		value, parseErr := parser(valueStr)
+		if parseErr != nil && usedDefault {
+			return fmt.Errorf("config: invalid default for field '%s': %s", structField.Name, parseErr)
+		}
 		if parseErr != nil {
 			return fmt.Errorf("config: error parsing value for field '%s': %s", structField.Name, parseErr)
 		}
@@ -166,6 +359,8 @@ func init() {
 		reflect.TypeFor[int64]():         func(value string) (any, error) { return strconv.ParseInt(value, 10, 64) },
 		reflect.TypeFor[float64]():       func(value string) (any, error) { return strconv.ParseFloat(value, 64) },
 		reflect.TypeFor[time.Duration](): func(value string) (any, error) { return time.ParseDuration(value) },
+		reflect.TypeFor[time.Time]():     func(value string) (any, error) { return time.Parse(time.RFC3339, value) },
+		reflect.TypeFor[*url.URL]():      func(value string) (any, error) { return parseURL(value) },
 	}
 
 	globalProvider = newProviderInspector(NewEnvVarProvider())
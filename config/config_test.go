@@ -4,8 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
 	"reflect"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -291,6 +294,97 @@ func TestGetWithDefault(t *testing.T) {
 	}
 }
 
+func TestGetWithDefaultStrict(t *testing.T) {
+	tests := map[string]struct {
+		key             string
+		setExpectations func(p *stubProvider)
+		defaultValue    any
+		expected        any
+		expectErr       string
+	}{
+		"value_found": {
+			key: "intKey",
+			setExpectations: func(p *stubProvider) {
+				p.set("intKey", "3", nil)
+			},
+			defaultValue: 10,
+			expected:     3,
+		},
+		"default_when_key_absent": {
+			key: "missingKey",
+			setExpectations: func(p *stubProvider) {
+				p.set("missingKey", "", fmt.Errorf("key 'missingKey': %w", ErrKeyNotFound))
+			},
+			defaultValue: 10,
+			expected:     10,
+		},
+		"default_string_when_key_absent": {
+			key: "missingString",
+			setExpectations: func(p *stubProvider) {
+				p.set("missingString", "", fmt.Errorf("key 'missingString': %w", ErrKeyNotFound))
+			},
+			defaultValue: "fallback",
+			expected:     "fallback",
+		},
+		"error_when_provider_fails": {
+			key: "unreachableKey",
+			setExpectations: func(p *stubProvider) {
+				p.set("unreachableKey", "", errors.New("connection refused"))
+			},
+			defaultValue: 10,
+			expected:     0,
+			expectErr:    "config: connection refused",
+		},
+		"error_on_parsing": {
+			key: "errorIntKey",
+			setExpectations: func(p *stubProvider) {
+				p.set("errorIntKey", "string_value", nil)
+			},
+			defaultValue: 10,
+			expected:     0,
+			expectErr:    "config: strconv.Atoi: parsing \"string_value\": invalid syntax",
+		},
+		"error_when_no_parser_for_type": {
+			key:          "uintKey",
+			defaultValue: uint(7),
+			expected:     uint(0),
+			expectErr:    "config: parser for type 'uint' does not exist",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			stub := &stubProvider{}
+			if tt.setExpectations != nil {
+				tt.setExpectations(stub)
+			}
+			SetGlobalProvider(stub)
+			ctx := context.Background()
+
+			// the second lookup is served from the cache and must behave like the first
+			for range 2 {
+				var (
+					result any
+					err    error
+				)
+				switch def := tt.defaultValue.(type) {
+				case int:
+					result, err = GetWithDefaultStrict(ctx, tt.key, def)
+				case string:
+					result, err = GetWithDefaultStrict(ctx, tt.key, def)
+				case uint:
+					result, err = GetWithDefaultStrict(ctx, tt.key, def)
+				}
+
+				assertErrorMessage(t, err, tt.expectErr)
+				if !reflect.DeepEqual(tt.expected, result) {
+					t.Fatalf("expected result %v, got %v", tt.expected, result)
+				}
+			}
+		})
+	}
+}
+
 func TestLoadStruct(t *testing.T) {
 	RegisterParser(func(name string) ([]string, error) {
 		return strings.Split(name, ","), nil
@@ -415,6 +509,70 @@ func TestLoadStruct(t *testing.T) {
 	}
 }
 
+func TestLoadStruct_DefaultExpansion(t *testing.T) {
+	type pathConfig struct {
+		DataDir string `config:"DATA_DIR" default:"${BASE_DIR}/data"`
+	}
+
+	tests := map[string]struct {
+		strict          bool
+		setExpectations func(p *stubProvider)
+		env             map[string]string
+		expected        string
+		expectedErr     string
+	}{
+		"expands_from_provider": {
+			setExpectations: func(p *stubProvider) {
+				p.set("DATA_DIR", "", errors.New("key not found"))
+				p.set("BASE_DIR", "/srv", nil)
+			},
+			expected: "/srv/data",
+		},
+		"expands_from_os_env": {
+			setExpectations: func(p *stubProvider) {
+				p.set("DATA_DIR", "", errors.New("key not found"))
+			},
+			env:      map[string]string{"BASE_DIR": "/home/app"},
+			expected: "/home/app/data",
+		},
+		"configured_value_is_not_expanded": {
+			setExpectations: func(p *stubProvider) {
+				p.set("DATA_DIR", "${BASE_DIR}/custom", nil)
+			},
+			expected: "${BASE_DIR}/custom",
+		},
+		"unresolved_is_left_intact": {
+			setExpectations: func(p *stubProvider) {
+				p.set("DATA_DIR", "", errors.New("key not found"))
+			},
+			expected: "${BASE_DIR}/data",
+		},
+		"unresolved_in_strict_mode": {
+			strict: true,
+			setExpectations: func(p *stubProvider) {
+				p.set("DATA_DIR", "", errors.New("key not found"))
+			},
+			expectedErr: "config: error expanding default for field 'DataDir': variable 'BASE_DIR' referenced by default value is not set",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			SetStrictDefaultExpansion(tt.strict)
+			defer SetStrictDefaultExpansion(false)
+
+			stub := &stubProvider{}
+			tt.setExpectations(stub)
+			SetGlobalProvider(stub)
+
+			loadStructAndAssert(t, context.Background(), &pathConfig{}, &pathConfig{DataDir: tt.expected}, tt.expectedErr)
+		})
+	}
+}
+
 func loadStructAndAssert[T any](t *testing.T, ctx context.Context, target *T, expected *T, expectedErr string) {
 	err := LoadStruct(ctx, target)
 	assertErrorMessage(t, err, expectedErr)
@@ -528,3 +686,502 @@ func TestSetGlobalProvider_keepsPreviousIntrospectionData(t *testing.T) {
 		t.Fatalf("expected introspection data for key %q to exist", "second-key")
 	}
 }
+
+func TestRegisterParser_ConcurrentWithReads(t *testing.T) {
+	defer ResetGlobalProvider()
+	p := &stubProvider{}
+	p.set("port", "8080", nil)
+	SetGlobalProvider(p)
+
+	type port int
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			RegisterParser(func(value string) (port, error) {
+				n, err := strconv.Atoi(value)
+				return port(n), err
+			})
+		}()
+		go func() {
+			defer wg.Done()
+			if v, err := Get[int](context.Background(), "port"); err != nil || v != 8080 {
+				t.Errorf("expected 8080, got %v, %v", v, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if v, err := Get[port](context.Background(), "port"); err != nil || v != 8080 {
+		t.Fatalf("expected registered parser to be used, got %v, %v", v, err)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	type databaseConfig struct {
+		Host string        `config:"DB_HOST"`
+		Port int           `config:"DB_PORT" default:"5432"`
+		TTL  time.Duration `config:"DB_TTL" default:"1m"`
+	}
+
+	tests := map[string]struct {
+		values      map[string]string
+		expected    databaseConfig
+		expectedErr string
+	}{
+		"populates-fields-and-defaults": {
+			values:   map[string]string{"DB_HOST": "db.local", "DB_TTL": "5s"},
+			expected: databaseConfig{Host: "db.local", Port: 5432, TTL: 5 * time.Second},
+		},
+		"missing-key-returns-zero-value": {
+			values:      map[string]string{"DB_PORT": "1"},
+			expectedErr: "config: error getting value for field 'Host': unexpected config lookup for key \"DB_HOST\"",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer ResetGlobalProvider()
+			stub := &stubProvider{}
+			for k, v := range tt.values {
+				stub.set(k, v, nil)
+			}
+			SetGlobalProvider(stub)
+
+			cfg, err := Load[databaseConfig](context.Background())
+			assertErrorMessage(t, err, tt.expectedErr)
+			if cfg != tt.expected {
+				t.Fatalf("expected %+v, got %+v", tt.expected, cfg)
+			}
+		})
+	}
+}
+
+func TestLoadStruct_Time(t *testing.T) {
+	type (
+		rfc3339Config struct {
+			Start time.Time `config:"START"`
+		}
+		layoutConfig struct {
+			Start time.Time `config:"START_DATE" layout:"2006-01-02"`
+			End   time.Time `config:"END_DATE" layout:"2006-01-02" default:"2030-12-31"`
+		}
+		layoutOnString struct {
+			Start string `config:"START_DATE" layout:"2006-01-02"`
+		}
+	)
+
+	tests := map[string]struct {
+		values      map[string]string
+		load        func(ctx context.Context) (any, error)
+		expected    any
+		expectedErr string
+	}{
+		"rfc3339-by-default": {
+			values: map[string]string{"START": "2026-03-01T10:30:00Z"},
+			load: func(ctx context.Context) (any, error) {
+				return Load[rfc3339Config](ctx)
+			},
+			expected: rfc3339Config{Start: time.Date(2026, 3, 1, 10, 30, 0, 0, time.UTC)},
+		},
+		"custom-layout-and-default": {
+			values: map[string]string{"START_DATE": "2026-03-01"},
+			load: func(ctx context.Context) (any, error) {
+				return Load[layoutConfig](ctx)
+			},
+			expected: layoutConfig{
+				Start: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2030, 12, 31, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"value-not-matching-layout": {
+			values: map[string]string{"START_DATE": "01/03/2026"},
+			load: func(ctx context.Context) (any, error) {
+				return Load[layoutConfig](ctx)
+			},
+			expected:    layoutConfig{},
+			expectedErr: "config: error parsing value for field 'Start': parsing time \"01/03/2026\" as \"2006-01-02\": cannot parse \"01/03/2026\" as \"2006\"",
+		},
+		"layout-on-non-time-field": {
+			values: map[string]string{"START_DATE": "2026-03-01"},
+			load: func(ctx context.Context) (any, error) {
+				return Load[layoutOnString](ctx)
+			},
+			expected:    layoutOnString{},
+			expectedErr: "config: layout tag on field 'Start' requires type 'time.Time', got 'string'",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer ResetGlobalProvider()
+			stub := &stubProvider{}
+			for k, v := range tt.values {
+				stub.set(k, v, nil)
+			}
+			stub.set("END_DATE", "", errors.New("not set"))
+			SetGlobalProvider(stub)
+
+			got, err := tt.load(context.Background())
+			assertErrorMessage(t, err, tt.expectedErr)
+			if !reflect.DeepEqual(tt.expected, got) {
+				t.Fatalf("expected %+v, got %+v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestLoadStruct_URL(t *testing.T) {
+	type llmConfig struct {
+		Host     *url.URL `config:"LLM_MODEL_HOST"`
+		Fallback *url.URL `config:"LLM_FALLBACK_HOST" default:"http://localhost:11434"`
+	}
+
+	tests := map[string]struct {
+		host         string
+		expectedHost string
+		expectedErr  string
+	}{
+		"valid-url": {
+			host:         "https://models.internal:8443/v1",
+			expectedHost: "https://models.internal:8443/v1",
+		},
+		"missing-scheme": {
+			host:        "/v1/models",
+			expectedErr: "config: error parsing value for field 'Host': url '/v1/models' has no scheme",
+		},
+		"host-without-scheme": {
+			host:        "localhost:8080",
+			expectedErr: "config: error parsing value for field 'Host': url 'localhost:8080' has no host",
+		},
+		"missing-host-redacts-password": {
+			host:        "https://user:secret@",
+			expectedErr: "config: error parsing value for field 'Host': url 'https://user:xxxxx@' has no host",
+		},
+		"malformed": {
+			host:        "http://[::1",
+			expectedErr: "config: error parsing value for field 'Host': parse \"http://[::1\": missing ']' in host",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer ResetGlobalProvider()
+			stub := &stubProvider{}
+			stub.set("LLM_MODEL_HOST", tt.host, nil)
+			stub.set("LLM_FALLBACK_HOST", "", errors.New("not set"))
+			SetGlobalProvider(stub)
+
+			cfg, err := Load[llmConfig](context.Background())
+			assertErrorMessage(t, err, tt.expectedErr)
+			if tt.expectedErr != "" {
+				return
+			}
+			if cfg.Host.String() != tt.expectedHost {
+				t.Fatalf("expected host %q, got %q", tt.expectedHost, cfg.Host)
+			}
+			if cfg.Fallback.String() != "http://localhost:11434" {
+				t.Fatalf("expected default fallback host, got %q", cfg.Fallback)
+			}
+		})
+	}
+
+	t.Run("get", func(t *testing.T) {
+		defer ResetGlobalProvider()
+		stub := &stubProvider{}
+		stub.set("LLM_MODEL_HOST", "http://localhost:11434", nil)
+		SetGlobalProvider(stub)
+
+		host, err := Get[*url.URL](context.Background(), "LLM_MODEL_HOST")
+		if err != nil || host.JoinPath("api", "chat").String() != "http://localhost:11434/api/chat" {
+			t.Fatalf("expected parsed URL, got %v, %v", host, err)
+		}
+	})
+}
+
+func TestLoadStruct_Decode(t *testing.T) {
+	type (
+		tlsConfig struct {
+			Key  []byte `config:"TLS_KEY" decode:"base64"`
+			Salt string `config:"SALT" decode:"hex" default:"6869"`
+		}
+		decodeOnInt struct {
+			Port int `config:"PORT" decode:"base64"`
+		}
+		unknownDecode struct {
+			Key []byte `config:"TLS_KEY" decode:"base32"`
+		}
+		invalidDefault struct {
+			Salt []byte `config:"SALT" decode:"hex" default:"zz"`
+		}
+	)
+
+	tests := map[string]struct {
+		values      map[string]string
+		load        func(ctx context.Context) (any, error)
+		expected    any
+		expectedErr string
+	}{
+		"base64-and-hex-default": {
+			values: map[string]string{"TLS_KEY": "c2VjcmV0"},
+			load: func(ctx context.Context) (any, error) {
+				return Load[tlsConfig](ctx)
+			},
+			expected: tlsConfig{Key: []byte("secret"), Salt: "hi"},
+		},
+		"hex-value": {
+			values: map[string]string{"TLS_KEY": "c2VjcmV0", "SALT": "0aff"},
+			load: func(ctx context.Context) (any, error) {
+				return Load[tlsConfig](ctx)
+			},
+			expected: tlsConfig{Key: []byte("secret"), Salt: "\x0a\xff"},
+		},
+		"invalid-base64": {
+			values: map[string]string{"TLS_KEY": "not base64!"},
+			load: func(ctx context.Context) (any, error) {
+				return Load[tlsConfig](ctx)
+			},
+			expected:    tlsConfig{},
+			expectedErr: "config: error parsing value for field 'Key': invalid base64 value: illegal data at input byte 3",
+		},
+		"invalid-hex": {
+			values: map[string]string{"TLS_KEY": "c2VjcmV0", "SALT": "abc"},
+			load: func(ctx context.Context) (any, error) {
+				return Load[tlsConfig](ctx)
+			},
+			expected:    tlsConfig{},
+			expectedErr: "config: error parsing value for field 'Salt': invalid hex value: odd length",
+		},
+		"invalid-hex-default": {
+			load: func(ctx context.Context) (any, error) {
+				return Load[invalidDefault](ctx)
+			},
+			expected:    invalidDefault{},
+			expectedErr: "config: invalid default for field 'Salt': invalid hex value: illegal character",
+		},
+		"decode-on-unsupported-type": {
+			values: map[string]string{"PORT": "ODA4MA=="},
+			load: func(ctx context.Context) (any, error) {
+				return Load[decodeOnInt](ctx)
+			},
+			expected:    decodeOnInt{},
+			expectedErr: "config: decode tag on field 'Port' requires type '[]byte' or 'string', got 'int'",
+		},
+		"unknown-decode": {
+			values: map[string]string{"TLS_KEY": "c2VjcmV0"},
+			load: func(ctx context.Context) (any, error) {
+				return Load[unknownDecode](ctx)
+			},
+			expected:    unknownDecode{},
+			expectedErr: "config: unknown decode 'base32' on field 'Key', expected 'base64' or 'hex'",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer ResetGlobalProvider()
+			stub := &stubProvider{}
+			stub.set("SALT", "", errors.New("not set"))
+			for k, v := range tt.values {
+				stub.set(k, v, nil)
+			}
+			SetGlobalProvider(stub)
+
+			got, err := tt.load(context.Background())
+			assertErrorMessage(t, err, tt.expectedErr)
+			if !reflect.DeepEqual(tt.expected, got) {
+				t.Fatalf("expected %+v, got %+v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestLoadStruct_NamedProvider(t *testing.T) {
+	type (
+		settings struct {
+			APIKey  string `config:"API_KEY" provider:"vault"`
+			Region  string `config:"REGION"`
+			Timeout int    `config:"TIMEOUT" provider:"vault" default:"5"`
+		}
+		unknownProvider struct {
+			APIKey string `config:"API_KEY" provider:"consul"`
+		}
+	)
+
+	tests := map[string]struct {
+		vault       map[string]string
+		load        func(ctx context.Context) (any, error)
+		expected    any
+		expectedErr string
+	}{
+		"routes-tagged-keys-to-named-provider": {
+			vault: map[string]string{"API_KEY": "from-vault", "REGION": "vault-region", "TIMEOUT": "30"},
+			load: func(ctx context.Context) (any, error) {
+				return Load[settings](ctx)
+			},
+			expected: settings{APIKey: "from-vault", Region: "env-region", Timeout: 30},
+		},
+		"default-when-missing-from-named-provider": {
+			vault: map[string]string{"API_KEY": "from-vault"},
+			load: func(ctx context.Context) (any, error) {
+				return Load[settings](ctx)
+			},
+			expected: settings{APIKey: "from-vault", Region: "env-region", Timeout: 5},
+		},
+		"unregistered-provider": {
+			load: func(ctx context.Context) (any, error) {
+				return Load[unknownProvider](ctx)
+			},
+			expected:    unknownProvider{},
+			expectedErr: "config: provider 'consul' for field 'APIKey' is not registered",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer ResetGlobalProvider()
+			global := &stubProvider{}
+			global.set("API_KEY", "from-env", nil)
+			global.set("REGION", "env-region", nil)
+			SetGlobalProvider(global)
+			vault := &stubProvider{}
+			vault.set("TIMEOUT", "", errors.New("not set"))
+			for k, v := range tt.vault {
+				vault.set(k, v, nil)
+			}
+			if err := RegisterNamedProvider("vault", vault); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			got, err := tt.load(context.Background())
+			assertErrorMessage(t, err, tt.expectedErr)
+			if !reflect.DeepEqual(tt.expected, got) {
+				t.Fatalf("expected %+v, got %+v", tt.expected, got)
+			}
+		})
+	}
+
+	t.Run("introspection-records-named-provider", func(t *testing.T) {
+		defer ResetGlobalProvider()
+		global := &stubProvider{}
+		global.set("REGION", "env-region", nil)
+		SetGlobalProvider(global)
+		vault := &stubProvider{}
+		vault.set("API_KEY", "from-vault", nil)
+		vault.set("TIMEOUT", "30", nil)
+		_ = RegisterNamedProvider("vault", vault)
+
+		for range 2 { // the second load is served from the cache
+			if _, err := Load[settings](context.Background()); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		}
+
+		for _, access := range IntrospectConfigAccesses() {
+			wantProvider, wantAuthoritative := "*config.stubProvider", false
+			if access.Key != "REGION" {
+				wantProvider, wantAuthoritative = "vault", true
+			}
+			if access.Key == "TIMEOUT" {
+				wantProvider, wantAuthoritative = "", false // fields with a default record no provider
+			}
+			if access.Provider != wantProvider || access.AuthoritativeSource != wantAuthoritative {
+				t.Fatalf("expected %s to be read from %q (authoritative %v), got %+v", access.Key, wantProvider, wantAuthoritative, access)
+			}
+		}
+	})
+
+	t.Run("register-and-remove", func(t *testing.T) {
+		defer ResetGlobalProvider()
+		if err := RegisterNamedProvider("", &stubProvider{}); err == nil || err.Error() != "config: provider name must not be empty" {
+			t.Fatalf("expected empty name error, got %v", err)
+		}
+
+		vault := &stubProvider{}
+		vault.set("API_KEY", "from-vault", nil)
+		_ = RegisterNamedProvider("vault", vault)
+		_ = RegisterNamedProvider("vault", nil)
+		if globalProvider.hasNamedProvider("vault") {
+			t.Fatal("expected nil provider to remove the registration")
+		}
+	})
+}
+
+func TestLoadStruct_InvalidDefault(t *testing.T) {
+	type (
+		pollConfig struct {
+			PollInterval time.Duration `config:"POLL_INTERVAL" default:"2"`
+		}
+		validPollConfig struct {
+			PollInterval time.Duration `config:"POLL_INTERVAL" default:"2s"`
+		}
+		expandedPollConfig struct {
+			PollInterval time.Duration `config:"POLL_INTERVAL" default:"${BASE_INTERVAL}"`
+		}
+	)
+
+	tests := map[string]struct {
+		values      map[string]string
+		load        func(ctx context.Context) (any, error)
+		expected    any
+		expectedErr string
+	}{
+		"invalid-default-fails-when-value-is-set": {
+			values: map[string]string{"POLL_INTERVAL": "5s"},
+			load: func(ctx context.Context) (any, error) {
+				return Load[pollConfig](ctx)
+			},
+			expected:    pollConfig{},
+			expectedErr: "config: invalid default for field 'PollInterval': time: missing unit in duration \"2\"",
+		},
+		"invalid-default-fails-when-value-is-missing": {
+			load: func(ctx context.Context) (any, error) {
+				return Load[pollConfig](ctx)
+			},
+			expected:    pollConfig{},
+			expectedErr: "config: invalid default for field 'PollInterval': time: missing unit in duration \"2\"",
+		},
+		"valid-default-is-used": {
+			load: func(ctx context.Context) (any, error) {
+				return Load[validPollConfig](ctx)
+			},
+			expected: validPollConfig{PollInterval: 2 * time.Second},
+		},
+		"expanded-default-is-validated-when-used": {
+			values: map[string]string{"BASE_INTERVAL": "3"},
+			load: func(ctx context.Context) (any, error) {
+				return Load[expandedPollConfig](ctx)
+			},
+			expected:    expandedPollConfig{},
+			expectedErr: "config: invalid default for field 'PollInterval': time: missing unit in duration \"3\"",
+		},
+		"expanded-default-not-validated-when-value-is-set": {
+			values: map[string]string{"POLL_INTERVAL": "5s"},
+			load: func(ctx context.Context) (any, error) {
+				return Load[expandedPollConfig](ctx)
+			},
+			expected: expandedPollConfig{PollInterval: 5 * time.Second},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer ResetGlobalProvider()
+			stub := &stubProvider{}
+			for k, v := range tt.values {
+				stub.set(k, v, nil)
+			}
+			if _, ok := tt.values["POLL_INTERVAL"]; !ok {
+				stub.set("POLL_INTERVAL", "", errors.New("not set"))
+			}
+			SetGlobalProvider(stub)
+
+			got, err := tt.load(context.Background())
+			assertErrorMessage(t, err, tt.expectedErr)
+			if !reflect.DeepEqual(tt.expected, got) {
+				t.Fatalf("expected %+v, got %+v", tt.expected, got)
+			}
+		})
+	}
+}
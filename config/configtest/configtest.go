@@ -0,0 +1,74 @@
+// Package configtest provides config.Provider implementations for tests.
+package configtest
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/cleitonmarx/symbiont/config"
+)
+
+// MapProvider serves configuration values from an in-memory map.
+// It implements config.Provider and config.ProviderWithSource.
+type MapProvider struct {
+	values map[string]string
+	source string
+}
+
+// NewMapProvider creates a provider serving a copy of values.
+// Lookups of keys not in the map fail with config.ErrKeyNotFound, so defaults apply as they
+// would for a real provider.
+func NewMapProvider(values map[string]string) *MapProvider {
+	return &MapProvider{values: maps.Clone(values)}
+}
+
+// WithSource sets the provider name reported by GetWithSource, which introspection records
+// as the source of each value. By default the provider's type name is reported.
+func (p *MapProvider) WithSource(source string) *MapProvider {
+	p.source = source
+	return p
+}
+
+// Get retrieves the value stored for name.
+func (p *MapProvider) Get(ctx context.Context, name string) (string, error) {
+	value, _, err := p.GetWithSource(ctx, name)
+	return value, err
+}
+
+// GetWithSource retrieves the value stored for name and reports the provider source.
+func (p *MapProvider) GetWithSource(_ context.Context, name string) (string, string, error) {
+	value, ok := p.values[name]
+	if !ok {
+		return "", "", fmt.Errorf("key '%s' is not in the map: %w", name, config.ErrKeyNotFound)
+	}
+	return value, p.sourceName(), nil
+}
+
+func (p *MapProvider) sourceName() string {
+	if p.source == "" {
+		return fmt.Sprintf("%T", p)
+	}
+	return p.source
+}
+
+// ErrorProvider fails every lookup with the same error.
+// It implements config.Provider and config.ProviderWithSource.
+type ErrorProvider struct {
+	err error
+}
+
+// NewErrorProvider creates a provider whose lookups all return err.
+func NewErrorProvider(err error) ErrorProvider {
+	return ErrorProvider{err: err}
+}
+
+// Get returns the provider's error.
+func (p ErrorProvider) Get(context.Context, string) (string, error) {
+	return "", p.err
+}
+
+// GetWithSource returns the provider's error.
+func (p ErrorProvider) GetWithSource(context.Context, string) (string, string, error) {
+	return "", "", p.err
+}
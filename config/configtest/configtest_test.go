@@ -0,0 +1,91 @@
+package configtest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont/config"
+)
+
+func TestMapProvider(t *testing.T) {
+	tests := map[string]struct {
+		provider   *MapProvider
+		key        string
+		wantValue  string
+		wantSource string
+		wantErr    string
+	}{
+		"found-with-default-source": {
+			provider:   NewMapProvider(map[string]string{"PORT": "8080"}),
+			key:        "PORT",
+			wantValue:  "8080",
+			wantSource: "*configtest.MapProvider",
+		},
+		"found-with-custom-source": {
+			provider:   NewMapProvider(map[string]string{"PORT": "8080"}).WithSource("vault"),
+			key:        "PORT",
+			wantValue:  "8080",
+			wantSource: "vault",
+		},
+		"missing-key": {
+			provider: NewMapProvider(nil),
+			key:      "PORT",
+			wantErr:  "key 'PORT' is not in the map: key not found",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			value, source, err := tt.provider.GetWithSource(context.Background(), tt.key)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+				if !errors.Is(err, config.ErrKeyNotFound) {
+					t.Fatalf("expected error to wrap config.ErrKeyNotFound, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if value != tt.wantValue || source != tt.wantSource {
+				t.Fatalf("expected %q from %q, got %q from %q", tt.wantValue, tt.wantSource, value, source)
+			}
+		})
+	}
+}
+
+func TestMapProvider_Introspection(t *testing.T) {
+	defer config.ResetGlobalProvider()
+	config.SetGlobalProvider(NewMapProvider(map[string]string{"PORT": "8080"}).WithSource("vault"))
+
+	port, err := config.Get[int](context.Background(), "PORT")
+	if err != nil || port != 8080 {
+		t.Fatalf("expected 8080, got %v, %v", port, err)
+	}
+	if got := config.GetWithDefault(context.Background(), "HOST", "localhost"); got != "localhost" {
+		t.Fatalf("expected default for missing key, got %q", got)
+	}
+	var sources []string
+	for _, access := range config.IntrospectConfigAccesses() {
+		if access.Key == "PORT" {
+			sources = append(sources, access.Provider)
+		}
+	}
+	if len(sources) != 1 || sources[0] != "vault" {
+		t.Fatalf("expected PORT to be read from %q, got %v", "vault", sources)
+	}
+}
+
+func TestErrorProvider(t *testing.T) {
+	wantErr := errors.New("unavailable")
+	p := NewErrorProvider(wantErr)
+	if _, err := p.Get(context.Background(), "PORT"); !errors.Is(err, wantErr) {
+		t.Fatalf("expected %v, got %v", wantErr, err)
+	}
+	if _, _, err := p.GetWithSource(context.Background(), "PORT"); !errors.Is(err, wantErr) {
+		t.Fatalf("expected %v, got %v", wantErr, err)
+	}
+}
@@ -0,0 +1,63 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync/atomic"
+)
+
+// encryptedTagName is the struct tag key that opts a config field into decryption
+const encryptedTagName = "encrypted"
+
+// DecryptFunc decrypts the raw value a provider returned for key, such as a Vault transit ciphertext.
+type DecryptFunc func(ctx context.Context, key, rawValue string) (string, error)
+
+// decryptor holds the active DecryptFunc; nil means values are used as read
+var decryptor atomic.Pointer[DecryptFunc]
+
+// SetDecryptor sets the function that decrypts the values of fields tagged encrypted:"true".
+// It is applied after a value is read from the provider and before it is parsed, so encrypted
+// values can be stored at rest and decrypted transparently by LoadStruct:
+//
+//	config.SetDecryptor(func(ctx context.Context, key, raw string) (string, error) {
+//		return transit.Decrypt(ctx, raw)
+//	})
+//
+//	type Secrets struct {
+//		APIKey string `config:"API_KEY" encrypted:"true"`
+//	}
+//
+// Defaults are plaintext and are never decrypted. Values are decrypted on every load, so a
+// decryptor calling a remote service should cache its results. A nil decryptor restores the
+// default, which uses values as read.
+func SetDecryptor(fn DecryptFunc) {
+	if fn == nil {
+		decryptor.Store(nil)
+		return
+	}
+	decryptor.Store(&fn)
+}
+
+// isEncryptedField reports whether a struct field opted into decryption with the encrypted tag.
+func isEncryptedField(structField reflect.StructField) (bool, error) {
+	tag, ok := structField.Tag.Lookup(encryptedTagName)
+	if !ok {
+		return false, nil
+	}
+	encrypted, err := strconv.ParseBool(tag)
+	if err != nil {
+		return false, fmt.Errorf("config: invalid encrypted tag '%s' on field '%s'", tag, structField.Name)
+	}
+	return encrypted, nil
+}
+
+// decrypt applies the active decryptor to a raw value read for key.
+func decrypt(ctx context.Context, key, rawValue string) (string, error) {
+	fn := decryptor.Load()
+	if fn == nil {
+		return rawValue, nil
+	}
+	return (*fn)(ctx, key, rawValue)
+}
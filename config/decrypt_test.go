@@ -0,0 +1,116 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoadStruct_Encrypted(t *testing.T) {
+	type (
+		secrets struct {
+			APIKey  string `config:"API_KEY" encrypted:"true"`
+			Region  string `config:"REGION"`
+			Timeout int    `config:"TIMEOUT" encrypted:"true" default:"5"`
+		}
+		encryptedAndDecoded struct {
+			Key []byte `config:"TLS_KEY" encrypted:"true" decode:"base64"`
+		}
+		invalidTag struct {
+			APIKey string `config:"API_KEY" encrypted:"yes"`
+		}
+	)
+
+	// reverse stands in for a real cipher: "enc:" followed by the reversed plaintext.
+	reverse := func(_ context.Context, key, raw string) (string, error) {
+		cipher, ok := strings.CutPrefix(raw, "enc:")
+		if !ok {
+			return "", errors.New("not a ciphertext for " + key)
+		}
+		runes := []rune(cipher)
+		for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+			runes[i], runes[j] = runes[j], runes[i]
+		}
+		return string(runes), nil
+	}
+
+	tests := map[string]struct {
+		decryptor   DecryptFunc
+		values      map[string]string
+		load        func(ctx context.Context) (any, error)
+		expected    any
+		expectedErr string
+	}{
+		"decrypts-tagged-fields-only": {
+			decryptor: reverse,
+			values:    map[string]string{"API_KEY": "enc:terces", "REGION": "enc:us"},
+			load: func(ctx context.Context) (any, error) {
+				return Load[secrets](ctx)
+			},
+			expected: secrets{APIKey: "secret", Region: "enc:us", Timeout: 5},
+		},
+		"decrypts-before-parsing": {
+			decryptor: reverse,
+			values:    map[string]string{"API_KEY": "enc:terces", "REGION": "eu", "TIMEOUT": "enc:03"},
+			load: func(ctx context.Context) (any, error) {
+				return Load[secrets](ctx)
+			},
+			expected: secrets{APIKey: "secret", Region: "eu", Timeout: 30},
+		},
+		"decrypts-before-decoding": {
+			decryptor: reverse,
+			values:    map[string]string{"TLS_KEY": "enc:yFmY"},
+			load: func(ctx context.Context) (any, error) {
+				return Load[encryptedAndDecoded](ctx)
+			},
+			expected: encryptedAndDecoded{Key: []byte("bar")},
+		},
+		"identity-by-default": {
+			values: map[string]string{"API_KEY": "enc:terces", "REGION": "eu"},
+			load: func(ctx context.Context) (any, error) {
+				return Load[secrets](ctx)
+			},
+			expected: secrets{APIKey: "enc:terces", Region: "eu", Timeout: 5},
+		},
+		"decryption-error": {
+			decryptor: reverse,
+			values:    map[string]string{"API_KEY": "plain", "REGION": "eu"},
+			load: func(ctx context.Context) (any, error) {
+				return Load[secrets](ctx)
+			},
+			expected:    secrets{},
+			expectedErr: "config: error decrypting value for field 'APIKey': not a ciphertext for API_KEY",
+		},
+		"invalid-tag": {
+			decryptor: reverse,
+			values:    map[string]string{"API_KEY": "enc:terces"},
+			load: func(ctx context.Context) (any, error) {
+				return Load[invalidTag](ctx)
+			},
+			expected:    invalidTag{},
+			expectedErr: "config: invalid encrypted tag 'yes' on field 'APIKey'",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer ResetGlobalProvider()
+			defer SetDecryptor(nil)
+			SetDecryptor(tt.decryptor)
+			stub := &stubProvider{}
+			stub.set("TIMEOUT", "", errors.New("not set"))
+			for k, v := range tt.values {
+				stub.set(k, v, nil)
+			}
+			SetGlobalProvider(stub)
+
+			got, err := tt.load(context.Background())
+			assertErrorMessage(t, err, tt.expectedErr)
+			if !reflect.DeepEqual(tt.expected, got) {
+				t.Fatalf("expected %+v, got %+v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -19,7 +19,7 @@ func NewEnvVarProvider() EnvVarProvider {
 func (p EnvVarProvider) Get(_ context.Context, name string) (string, error) {
 	value, exists := os.LookupEnv(name)
 	if !exists {
-		return "", fmt.Errorf("environment variable '%s' is not set", name)
+		return "", fmt.Errorf("environment variable '%s' is not set: %w", name, ErrKeyNotFound)
 	}
 	return value, nil
 }
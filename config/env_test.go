@@ -25,7 +25,7 @@ func TestEnvVarProvider_Get(t *testing.T) {
 		"missing_key": {
 			envKey:      "MISSING_KEY",
 			want:        "",
-			expectedErr: "environment variable 'MISSING_KEY' is not set",
+			expectedErr: "environment variable 'MISSING_KEY' is not set: key not found",
 		},
 	}
 
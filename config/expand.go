@@ -0,0 +1,47 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"sync/atomic"
+)
+
+var (
+	// defaultVarPattern matches ${VAR} references inside default tag values
+	defaultVarPattern = regexp.MustCompile(`\$\{([^}]+)\}`)
+	// strictDefaultExpansion makes unresolved ${VAR} references in defaults an error
+	strictDefaultExpansion atomic.Bool
+)
+
+// SetStrictDefaultExpansion controls how unresolved ${VAR} references in default tags are handled.
+// When strict, an unresolved variable fails the field; otherwise the reference is left intact.
+// Expansion is lenient by default.
+func SetStrictDefaultExpansion(strict bool) {
+	strictDefaultExpansion.Store(strict)
+}
+
+// expandDefault replaces ${VAR} references in a default value using the active provider,
+// falling back to the OS environment. Lookups made for expansion are not recorded as config accesses.
+func expandDefault(ctx context.Context, value string) (string, error) {
+	provider := globalProvider.currentProvider()
+	strict := strictDefaultExpansion.Load()
+
+	var unresolved []string
+	expanded := defaultVarPattern.ReplaceAllStringFunc(value, func(ref string) string {
+		name := defaultVarPattern.FindStringSubmatch(ref)[1]
+		if v, err := provider.Get(ctx, name); err == nil {
+			return v
+		}
+		if v, ok := os.LookupEnv(name); ok {
+			return v
+		}
+		unresolved = append(unresolved, name)
+		return ref
+	})
+	if strict && len(unresolved) > 0 {
+		return "", fmt.Errorf("variable '%s' referenced by default value is not set", unresolved[0])
+	}
+	return expanded, nil
+}
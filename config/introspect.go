@@ -2,10 +2,13 @@ package config
 
 import (
 	"context"
+	"fmt"
 	"reflect"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/cleitonmarx/symbiont/internal/reflectx"
 	"github.com/cleitonmarx/symbiont/introspection"
@@ -18,14 +21,43 @@ type ProviderWithSource interface {
 	GetWithSource(ctx context.Context, key string) (string, string, error)
 }
 
+// DeclaredKeys returns the configuration keys declared by the config-tagged fields of target,
+// a struct pointer, with the prefix carried by ctx applied. Nothing is read from the provider.
+func DeclaredKeys(ctx context.Context, target any) ([]introspection.ConfigDeclaration, error) {
+	v := reflect.ValueOf(target)
+	if !reflectx.IsPointerStruct(v) {
+		return nil, fmt.Errorf("target must be a struct pointer, got '%s'", reflectx.TypeNameOf(target))
+	}
+	component := reflectx.GetTypeName(v.Type())
+	var declared []introspection.ConfigDeclaration
+	for _, field := range reflectx.TaggedFields(target, tagName) {
+		declared = append(declared, introspection.ConfigDeclaration{
+			Key:       Prefix(ctx) + field.Tag,
+			Field:     field.Name,
+			Component: component,
+		})
+	}
+	return declared, nil
+}
+
 // providerInspector wraps a Provider and tracks all accessed keys and their sources for introspection.
 type providerInspector struct {
 	provider     Provider
 	providerName string
-	cache        map[string]string
-	mu           sync.Mutex
-	usedKeys     map[string][]introspection.ConfigAccess
-	order        int
+	// named holds the providers registered with RegisterNamedProvider
+	named map[string]Provider
+	// cache, absent, and sources are keyed by cacheKey, so each named provider has its own entries
+	cache    map[string]string
+	absent   map[string]error
+	sources  map[string]valueSource
+	mu       sync.Mutex
+	usedKeys map[string][]introspection.ConfigAccess
+	order    int
+	now      func() time.Time
+	// auditCap bounds the audit log; auditing is disabled when it is zero
+	auditCap   int
+	audit      []introspection.ConfigAccess
+	lastValues map[string]string
 }
 
 // newProviderInspector creates a new inspector wrapper for introspection and caching.
@@ -33,13 +65,39 @@ func newProviderInspector(p Provider) *providerInspector {
 	return &providerInspector{
 		provider:     p,
 		usedKeys:     make(map[string][]introspection.ConfigAccess),
+		named:        make(map[string]Provider),
 		cache:        make(map[string]string),
+		absent:       make(map[string]error),
+		sources:      make(map[string]valueSource),
 		providerName: reflectx.TypeNameOf(p),
+		now:          time.Now,
 	}
 }
 
+// SetAuditLogCap enables the config audit log, keeping at most the n most recent accesses.
+// A cap of zero or less disables the log and discards its entries. The log survives SetGlobalProvider,
+// so reads made through a new provider are compared with earlier reads of the same key.
+func SetAuditLogCap(n int) {
+	globalProvider.setAuditCap(n)
+}
+
+// ConfigAuditLog returns the recorded configuration accesses in chronological order, each with its
+// time and whether the value differed from the previous read of the same key. Values themselves are
+// not recorded. The log is empty unless SetAuditLogCap enabled it.
+func ConfigAuditLog() []introspection.ConfigAccess {
+	return globalProvider.auditLog()
+}
+
+// valueSource names the provider that supplied a value and whether the provider reported that
+// name itself through GetWithSource.
+type valueSource struct {
+	provider      string
+	authoritative bool
+}
+
 // recordKeyAccess records metadata about a configuration key access for introspection and debugging.
-func (i *providerInspector) recordKeyAccess(key, provider string, isDefaultConfigured bool, componentType reflect.Type, level int) {
+// The value is only kept, when auditing, to detect changes between reads.
+func (i *providerInspector) recordKeyAccess(key, value string, source valueSource, isDefaultConfigured bool, componentType reflect.Type, level int) {
 	callerFunc, file, line := reflectx.GetCallerName(level + 1)
 	caller := reflectx.FormatFunctionName(callerFunc)
 	if strings.Contains(caller, "symbiont.(*App).") {
@@ -52,7 +110,7 @@ func (i *providerInspector) recordKeyAccess(key, provider string, isDefaultConfi
 	}
 
 	if isDefaultConfigured {
-		provider = ""
+		source = valueSource{}
 	}
 
 	i.mu.Lock()
@@ -60,9 +118,10 @@ func (i *providerInspector) recordKeyAccess(key, provider string, isDefaultConfi
 	i.order++
 
 	info := introspection.ConfigAccess{
-		Key:         key,
-		Provider:    provider,
-		UsedDefault: isDefaultConfigured,
+		Key:                 key,
+		Provider:            source.provider,
+		AuthoritativeSource: source.authoritative,
+		UsedDefault:         isDefaultConfigured,
 		Caller: introspection.Caller{
 			Func: caller,
 			File: reflectx.FormatFileName(file),
@@ -72,30 +131,68 @@ func (i *providerInspector) recordKeyAccess(key, provider string, isDefaultConfi
 		Order:     i.order,
 	}
 	i.usedKeys[key] = append(i.usedKeys[key], info)
+
+	if i.auditCap > 0 {
+		info.Time = i.now()
+		prev, seen := i.lastValues[key]
+		info.Changed = seen && prev != value
+		i.lastValues[key] = value
+		i.audit = append(i.audit, info)
+		if excess := len(i.audit) - i.auditCap; excess > 0 {
+			i.audit = slices.Delete(i.audit, 0, excess)
+		}
+	}
+}
+
+// setAuditCap sets the audit log capacity, trimming or discarding the log as needed.
+func (i *providerInspector) setAuditCap(n int) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if n <= 0 {
+		i.auditCap, i.audit, i.lastValues = 0, nil, nil
+		return
+	}
+	i.auditCap = n
+	if i.lastValues == nil {
+		i.lastValues = make(map[string]string)
+	}
+	if excess := len(i.audit) - n; excess > 0 {
+		i.audit = slices.Delete(i.audit, 0, excess)
+	}
+}
+
+// auditLog returns a copy of the audit log.
+func (i *providerInspector) auditLog() []introspection.ConfigAccess {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return slices.Clone(i.audit)
 }
 
 // get retrieves a configuration value from the provider, caching results and recording access metadata.
-func (i *providerInspector) get(ctx context.Context, key string, isUsingDefaultConfig bool, componentType reflect.Type, level int) (string, error) {
-	if val, providerName, ok := i.getFromCache(key); ok {
-		i.recordKeyAccess(key, providerName, isUsingDefaultConfig, componentType, level)
+// A non-empty providerName reads the key from the named provider registered under that name instead of
+// the global provider.
+func (i *providerInspector) get(ctx context.Context, providerName, key string, isUsingDefaultConfig bool, componentType reflect.Type, level int) (string, error) {
+	ck := cacheKey(providerName, key)
+	if val, source, ok := i.getFromCache(ck); ok {
+		i.recordKeyAccess(key, val, source, isUsingDefaultConfig, componentType, level)
 		return val, nil
 	}
 
-	var (
-		val          string
-		providerName string
-		err          error
-	)
+	provider, source, err := i.providerFor(providerName)
+	if err != nil {
+		return "", err
+	}
 
-	if srp, ok := i.provider.(ProviderWithSource); ok {
-		val, providerName, err = srp.GetWithSource(ctx, key)
+	var val string
+	if srp, ok := provider.(ProviderWithSource); ok {
+		val, source.provider, err = srp.GetWithSource(ctx, key)
+		source.authoritative = true
 	} else {
-		val, err = i.provider.Get(ctx, key)
-		providerName = i.providerName
+		val, err = provider.Get(ctx, key)
 	}
 
 	if isUsingDefaultConfig || err == nil {
-		i.recordKeyAccess(key, providerName, isUsingDefaultConfig, componentType, level)
+		i.recordKeyAccess(key, val, source, isUsingDefaultConfig, componentType, level)
 	}
 
 	// Return error only if not using a default configuration and an error occurred.
@@ -104,27 +201,89 @@ func (i *providerInspector) get(ctx context.Context, key string, isUsingDefaultC
 	}
 
 	i.mu.Lock()
-	i.cache[key] = val
+	i.cache[ck] = val
+	if err != nil {
+		i.absent[ck] = err
+	} else {
+		i.sources[ck] = source
+	}
 	i.mu.Unlock()
 
 	return val, err
 }
 
-// getFromCache retrieves a cached configuration value if available.
-func (i *providerInspector) getFromCache(key string) (string, string, bool) {
+// providerFor returns the global provider, or the named provider registered under name, with the
+// source recorded for the values it supplies when it does not report one itself.
+func (i *providerInspector) providerFor(name string) (Provider, valueSource, error) {
 	i.mu.Lock()
 	defer i.mu.Unlock()
-	val, ok := i.cache[key]
+	if name == "" {
+		return i.provider, valueSource{provider: i.providerName}, nil
+	}
+	p, ok := i.named[name]
 	if !ok {
-		return "", "", false
+		return nil, valueSource{}, fmt.Errorf("provider '%s' is not registered", name)
 	}
+	return p, valueSource{provider: name, authoritative: true}, nil
+}
+
+// hasNamedProvider reports whether a provider is registered under name.
+func (i *providerInspector) hasNamedProvider(name string) bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	_, ok := i.named[name]
+	return ok
+}
 
-	providerName := ""
-	if keys, ok := i.usedKeys[key]; ok && len(keys) > 0 {
-		providerName = keys[0].Provider
+// setNamedProvider registers p under name, or removes the registration when p is nil,
+// and resets the cache so no value read through a previous provider is served.
+func (i *providerInspector) setNamedProvider(name string, p Provider) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if p == nil {
+		delete(i.named, name)
+	} else {
+		i.named[name] = p
+	}
+	i.resetCache()
+}
+
+// cacheKey returns the cache key of a key read from the named provider, or from the global provider
+// when providerName is empty.
+func cacheKey(providerName, key string) string {
+	if providerName == "" {
+		return key
+	}
+	return providerName + "\x00" + key
+}
+
+// lookup behaves like get and additionally reports whether the provider actually supplied the key.
+// A key cached after a failed lookup with a default is reported as not found, along with the
+// error the provider returned for it.
+func (i *providerInspector) lookup(ctx context.Context, key string, isUsingDefaultConfig bool, componentType reflect.Type, level int) (string, bool, error) {
+	val, err := i.get(ctx, "", key, isUsingDefaultConfig, componentType, level+1)
+	if err != nil && !isUsingDefaultConfig {
+		return "", false, err
 	}
+	i.mu.Lock()
+	absentErr, isAbsent := i.absent[key]
+	i.mu.Unlock()
+	if isAbsent {
+		return "", false, absentErr
+	}
+	return val, true, nil
+}
 
-	return val, providerName, true
+// getFromCache retrieves a cached configuration value and the source of its first read if available.
+// key is a cacheKey.
+func (i *providerInspector) getFromCache(key string) (string, valueSource, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	val, ok := i.cache[key]
+	if !ok {
+		return "", valueSource{}, false
+	}
+	return val, i.sources[key], true
 }
 
 // getKeysAccessInfo returns all accessed keys sorted by key name, file, and line number.
@@ -139,13 +298,27 @@ func (i *providerInspector) getKeysAccessInfo() []introspection.ConfigAccess {
 	return out
 }
 
+// currentProvider returns the wrapped provider.
+func (i *providerInspector) currentProvider() Provider {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.provider
+}
+
 // setProvider updates the wrapped provider and resets the cache and access tracking.
 func (i *providerInspector) setProvider(p Provider) {
 	i.mu.Lock()
 	defer i.mu.Unlock()
 	i.provider = p
 	i.providerName = reflectx.TypeNameOf(p)
+	i.resetCache()
+}
+
+// resetCache discards all cached values. The caller must hold i.mu.
+func (i *providerInspector) resetCache() {
 	i.cache = make(map[string]string)
+	i.absent = make(map[string]error)
+	i.sources = make(map[string]valueSource)
 }
 
 // sortConfigAccesses orders config accesses by key, then file, then line, then order.
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/cleitonmarx/symbiont/introspection"
 )
@@ -121,14 +122,14 @@ func Test_providerInspector_get(t *testing.T) {
 			sp := simpleProvider{values: tt.providerValues}
 			ip := newProviderInspector(sp)
 
-			val, err := ip.get(context.Background(), tt.getKey, tt.withDefault, nil, 2)
+			val, err := ip.get(context.Background(), "", tt.getKey, tt.withDefault, nil, 2)
 			assertErrorMessage(t, err, tt.wantErr)
 			if val != tt.wantValue {
 				t.Fatalf("expected value %q, got %q", tt.wantValue, val)
 			}
 
 			if tt.repeatGet {
-				val2, err2 := ip.get(context.Background(), tt.getKey, tt.withDefault, nil, 2)
+				val2, err2 := ip.get(context.Background(), "", tt.getKey, tt.withDefault, nil, 2)
 				if val2 != tt.wantValue {
 					t.Fatalf("expected repeated value %q, got %q", tt.wantValue, val2)
 				}
@@ -170,7 +171,7 @@ func Test_providerInspector_get_usingGetWithSource(t *testing.T) {
 			getKey:         "foo",
 			wantValue:      "bar",
 			wantKeys: []introspection.ConfigAccess{
-				{Key: "foo", Provider: "*config.providerWithName", UsedDefault: false, Caller: introspection.Caller{Func: "config.(*providerInspector).get", File: "config/introspect.go"}},
+				{Key: "foo", Provider: "*config.providerWithName", AuthoritativeSource: true, UsedDefault: false, Caller: introspection.Caller{Func: "config.(*providerInspector).get", File: "config/introspect.go"}},
 			},
 		},
 		"does_not_record_on_error": {
@@ -188,8 +189,8 @@ func Test_providerInspector_get_usingGetWithSource(t *testing.T) {
 			wantValue:      "bar",
 			repeatGet:      true,
 			wantKeys: []introspection.ConfigAccess{
-				{UsedDefault: false, Key: "foo", Provider: "*config.providerWithName", Caller: introspection.Caller{Func: "config.(*providerInspector).get", File: "config/introspect.go"}},
-				{UsedDefault: false, Key: "foo", Provider: "*config.providerWithName", Caller: introspection.Caller{Func: "config.(*providerInspector).get", File: "config/introspect.go"}},
+				{UsedDefault: false, Key: "foo", Provider: "*config.providerWithName", AuthoritativeSource: true, Caller: introspection.Caller{Func: "config.(*providerInspector).get", File: "config/introspect.go"}},
+				{UsedDefault: false, Key: "foo", Provider: "*config.providerWithName", AuthoritativeSource: true, Caller: introspection.Caller{Func: "config.(*providerInspector).get", File: "config/introspect.go"}},
 			},
 		},
 		"records_with_empty_provider_tag": {
@@ -198,7 +199,7 @@ func Test_providerInspector_get_usingGetWithSource(t *testing.T) {
 			getKey:         "empty",
 			wantValue:      "val",
 			wantKeys: []introspection.ConfigAccess{
-				{UsedDefault: false, Key: "empty", Provider: "", Caller: introspection.Caller{Func: "config.(*providerInspector).get", File: "config/introspect.go"}},
+				{UsedDefault: false, Key: "empty", Provider: "", AuthoritativeSource: true, Caller: introspection.Caller{Func: "config.(*providerInspector).get", File: "config/introspect.go"}},
 			},
 		},
 		"records_with_provider_tag_and_default": {
@@ -221,14 +222,14 @@ func Test_providerInspector_get_usingGetWithSource(t *testing.T) {
 			p := providerWithName{values: tt.providerValues, providerTag: tt.providerTag}
 			ip := newProviderInspector(p)
 
-			val, err := ip.get(context.Background(), tt.getKey, tt.defaultValue, nil, 1)
+			val, err := ip.get(context.Background(), "", tt.getKey, tt.defaultValue, nil, 1)
 			assertErrorMessage(t, err, tt.wantErr)
 			if val != tt.wantValue {
 				t.Fatalf("expected value %q, got %q", tt.wantValue, val)
 			}
 
 			if tt.repeatGet {
-				val, err := ip.get(context.Background(), tt.getKey, false, nil, 1)
+				val, err := ip.get(context.Background(), "", tt.getKey, false, nil, 1)
 				if val != tt.wantValue {
 					t.Fatalf("expected repeated value %q, got %q", tt.wantValue, val)
 				}
@@ -257,8 +258,8 @@ func Test_providerInspector_getKeysAccessInfo_sorted(t *testing.T) {
 	sp := &simpleProvider{values: map[string]string{"b": "2", "a": "1"}}
 	ip := newProviderInspector(sp)
 
-	_, _ = ip.get(context.Background(), "b", false, nil, 1)
-	_, _ = ip.get(context.Background(), "a", false, nil, 1)
+	_, _ = ip.get(context.Background(), "", "b", false, nil, 1)
+	_, _ = ip.get(context.Background(), "", "a", false, nil, 1)
 
 	keys := ip.getKeysAccessInfo()
 	if keys[0].Key != "a" {
@@ -268,3 +269,121 @@ func Test_providerInspector_getKeysAccessInfo_sorted(t *testing.T) {
 		t.Fatalf("expected second key %q, got %q", "b", keys[1].Key)
 	}
 }
+
+func TestDeclaredKeys(t *testing.T) {
+	type embedded struct {
+		Host string `config:"HOST"`
+	}
+	type server struct {
+		embedded
+		Port    int    `config:"PORT" default:"80"`
+		Name    string `resolve:""`
+		Untyped string
+	}
+
+	tests := map[string]struct {
+		ctx         context.Context
+		target      any
+		expected    []introspection.ConfigDeclaration
+		expectedErr string
+	}{
+		"struct-pointer": {
+			ctx:    context.Background(),
+			target: &server{},
+			expected: []introspection.ConfigDeclaration{
+				{Key: "HOST", Field: "Host", Component: "*config.server"},
+				{Key: "PORT", Field: "Port", Component: "*config.server"},
+			},
+		},
+		"prefixed": {
+			ctx:    WithPrefix(context.Background(), "ADMIN_"),
+			target: &server{},
+			expected: []introspection.ConfigDeclaration{
+				{Key: "ADMIN_HOST", Field: "Host", Component: "*config.server"},
+				{Key: "ADMIN_PORT", Field: "Port", Component: "*config.server"},
+			},
+		},
+		"not-a-struct-pointer": {
+			ctx:         context.Background(),
+			target:      server{},
+			expectedErr: "target must be a struct pointer, got 'config.server'",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer ResetGlobalProvider()
+			got, err := DeclaredKeys(tt.ctx, tt.target)
+			assertErrorMessage(t, err, tt.expectedErr)
+			if !reflect.DeepEqual(tt.expected, got) {
+				t.Fatalf("expected %+v, got %+v", tt.expected, got)
+			}
+			if accesses := IntrospectConfigAccesses(); len(accesses) != 0 {
+				t.Fatalf("expected no config accesses, got %+v", accesses)
+			}
+		})
+	}
+}
+
+func TestConfigAuditLog(t *testing.T) {
+	defer ResetGlobalProvider()
+	ctx := context.Background()
+
+	if log := ConfigAuditLog(); len(log) != 0 {
+		t.Fatalf("expected an empty audit log while disabled, got %+v", log)
+	}
+
+	SetAuditLogCap(4)
+	start := time.Now()
+	first := &stubProvider{}
+	first.set("POLL_INTERVAL", "1s", nil)
+	first.set("MODE", "fast", nil)
+	SetGlobalProvider(first)
+	_, _ = Get[time.Duration](ctx, "POLL_INTERVAL")
+	_, _ = Get[string](ctx, "MODE")
+	_, _ = Get[time.Duration](ctx, "POLL_INTERVAL") // served from the cache, unchanged
+
+	second := &stubProvider{}
+	second.set("POLL_INTERVAL", "5s", nil)
+	second.set("MODE", "fast", nil)
+	SetGlobalProvider(second)
+	_, _ = Get[time.Duration](ctx, "POLL_INTERVAL")
+	_, _ = Get[string](ctx, "MODE")
+
+	type entry struct {
+		key     string
+		changed bool
+	}
+	var got []entry
+	log := ConfigAuditLog()
+	for i, access := range log {
+		got = append(got, entry{access.Key, access.Changed})
+		if access.Time.Before(start) || (i > 0 && access.Time.Before(log[i-1].Time)) {
+			t.Fatalf("expected chronological timestamps, got %+v", log)
+		}
+	}
+	want := []entry{
+		{"MODE", false},
+		{"POLL_INTERVAL", false},
+		{"POLL_INTERVAL", true},
+		{"MODE", false},
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Fatalf("expected the 4 most recent accesses %+v, got %+v", want, got)
+	}
+
+	for _, access := range IntrospectConfigAccesses() {
+		if !access.Time.IsZero() || access.Changed {
+			t.Fatalf("expected introspection accesses without audit fields, got %+v", access)
+		}
+	}
+
+	SetAuditLogCap(1)
+	if log := ConfigAuditLog(); len(log) != 1 || log[0].Key != "MODE" {
+		t.Fatalf("expected the log to be trimmed to the latest access, got %+v", log)
+	}
+	SetAuditLogCap(0)
+	if log := ConfigAuditLog(); len(log) != 0 {
+		t.Fatalf("expected disabling to discard the log, got %+v", log)
+	}
+}
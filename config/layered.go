@@ -0,0 +1,66 @@
+package config
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cleitonmarx/symbiont/internal/reflectx"
+)
+
+// NamedProvider is a provider with the name of the layer it serves in a LayeredProvider.
+type NamedProvider struct {
+	Name     string
+	Provider Provider
+}
+
+// LayeredProvider resolves each key from the highest layer that has it, following the override
+// pattern where a base file is overridden by the environment, which is overridden by CLI flags.
+// Unlike CompositeProvider, whose first provider wins, layers are listed from the lowest precedence
+// to the highest, and the winning layer's name is reported to introspection as the key's source.
+type LayeredProvider struct {
+	layers []NamedProvider
+}
+
+// NewLayeredProvider creates a provider from layers listed in increasing precedence:
+//
+//	config.NewLayeredProvider(
+//		config.NamedProvider{Name: "file", Provider: fileProvider},
+//		config.NamedProvider{Name: "env", Provider: config.NewEnvVarProvider()},
+//		config.NamedProvider{Name: "flags", Provider: flagProvider},
+//	)
+//
+// A layer without a name is reported under its provider's type name.
+func NewLayeredProvider(layers ...NamedProvider) LayeredProvider {
+	named := make([]NamedProvider, len(layers))
+	for i, l := range layers {
+		if l.Name == "" {
+			l.Name = reflectx.TypeNameOf(l.Provider)
+		}
+		named[i] = l
+	}
+	return LayeredProvider{layers: named}
+}
+
+// Get retrieves a configuration value from the highest layer that has it.
+func (p LayeredProvider) Get(ctx context.Context, name string) (string, error) {
+	value, _, err := p.GetWithSource(ctx, name)
+	return value, err
+}
+
+// GetWithSource retrieves a configuration value from the highest layer that has it and reports the
+// layer's name. When no layer has the key, the error lists each layer's error, highest first.
+func (p LayeredProvider) GetWithSource(ctx context.Context, name string) (string, string, error) {
+	var errs []error
+	for i := len(p.layers) - 1; i >= 0; i-- {
+		layer := p.layers[i]
+		value, err := layer.Provider.Get(ctx, name)
+		if err == nil {
+			return value, layer.Name, nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", layer.Name, err))
+	}
+	if len(errs) == 0 {
+		return "", "", fmt.Errorf("%w: no layers configured", ErrKeyNotFound)
+	}
+	return "", "", joinLookupErrors(errs)
+}
@@ -0,0 +1,129 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestLayeredProvider_GetWithSource(t *testing.T) {
+	tests := map[string]struct {
+		setStubs       func(file, env, flags *stubProvider)
+		expectedValue  string
+		expectedSource string
+		expectedError  string
+	}{
+		"highest-layer-wins": {
+			setStubs: func(file, env, flags *stubProvider) {
+				flags.set("PORT", "9090", nil)
+			},
+			expectedValue:  "9090",
+			expectedSource: "flags",
+		},
+		"falls-through-to-lower-layers": {
+			setStubs: func(file, env, flags *stubProvider) {
+				flags.set("PORT", "", errors.New("flag not set"))
+				env.set("PORT", "", errors.New("env not set"))
+				file.set("PORT", "8080", nil)
+			},
+			expectedValue:  "8080",
+			expectedSource: "file",
+		},
+		"env-overrides-file": {
+			setStubs: func(file, env, flags *stubProvider) {
+				flags.set("PORT", "", errors.New("flag not set"))
+				env.set("PORT", "8081", nil)
+			},
+			expectedValue:  "8081",
+			expectedSource: "env",
+		},
+		"not-found-in-any-layer": {
+			setStubs: func(file, env, flags *stubProvider) {
+				flags.set("PORT", "", errors.New("flag not set"))
+				env.set("PORT", "", errors.New("env not set"))
+				file.set("PORT", "", errors.New("file not set"))
+			},
+			expectedError: "flags: flag not set\nenv: env not set\nfile: file not set",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			file, env, flags := &stubProvider{}, &stubProvider{}, &stubProvider{}
+			tt.setStubs(file, env, flags)
+			p := NewLayeredProvider(
+				NamedProvider{Name: "file", Provider: file},
+				NamedProvider{Name: "env", Provider: env},
+				NamedProvider{Name: "flags", Provider: flags},
+			)
+			value, source, err := p.GetWithSource(context.Background(), "PORT")
+			assertErrorMessage(t, err, tt.expectedError)
+			if value != tt.expectedValue || source != tt.expectedSource {
+				t.Fatalf("expected %q from %q, got %q from %q", tt.expectedValue, tt.expectedSource, value, source)
+			}
+		})
+	}
+}
+
+func TestLayeredProvider_Introspection(t *testing.T) {
+	defer ResetGlobalProvider()
+	base, override := &stubProvider{}, &stubProvider{}
+	base.set("HOST", "localhost", nil)
+	base.set("PORT", "8080", nil)
+	override.set("HOST", "", errors.New("not set"))
+	override.set("PORT", "9090", nil)
+	SetGlobalProvider(NewLayeredProvider(
+		NamedProvider{Name: "base", Provider: base},
+		NamedProvider{Provider: override},
+	))
+
+	ctx := context.Background()
+	if _, err := GetMany(ctx, "HOST", "PORT"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	sources := map[string]string{}
+	for _, access := range IntrospectConfigAccesses() {
+		if !access.AuthoritativeSource {
+			t.Fatalf("expected %s to report an authoritative source", access.Key)
+		}
+		sources[access.Key] = access.Provider
+	}
+	if sources["HOST"] != "base" || sources["PORT"] != "*config.stubProvider" {
+		t.Fatalf("expected winning layers per key, got %v", sources)
+	}
+
+	_, _, err := NewLayeredProvider().GetWithSource(ctx, "HOST")
+	assertErrorMessage(t, err, "key not found: no layers configured")
+}
+
+func TestLayeredProvider_KeyNotFound(t *testing.T) {
+	tests := map[string]struct {
+		errs         []error
+		wantNotFound bool
+	}{
+		"all-not-found": {
+			errs:         []error{fmt.Errorf("key %w", ErrKeyNotFound), fmt.Errorf("key %w", ErrKeyNotFound)},
+			wantNotFound: true,
+		},
+		"one-layer-failed": {
+			errs: []error{fmt.Errorf("key %w", ErrKeyNotFound), errors.New("connection refused")},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			base, override := &stubProvider{}, &stubProvider{}
+			base.set("key", "", tt.errs[0])
+			override.set("key", "", tt.errs[1])
+
+			_, _, err := NewLayeredProvider(
+				NamedProvider{Name: "base", Provider: base},
+				NamedProvider{Name: "override", Provider: override},
+			).GetWithSource(context.Background(), "key")
+			if err == nil || errors.Is(err, ErrKeyNotFound) != tt.wantNotFound {
+				t.Fatalf("expected errors.Is(err, ErrKeyNotFound) to be %v, got %v", tt.wantNotFound, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package config
+
+import "context"
+
+// prefixKey is the context key under which the config key prefix is stored.
+type prefixKey struct{}
+
+// WithPrefix returns a context under which configuration keys are read with prefix prepended,
+// so `config:"HTTP_PORT"` reads ADMIN_HTTP_PORT under WithPrefix(ctx, "ADMIN_").
+// Prefixes nest: a prefix added to an already prefixed context is appended to the existing one.
+// Introspection records the fully-qualified key.
+func WithPrefix(ctx context.Context, prefix string) context.Context {
+	return context.WithValue(ctx, prefixKey{}, Prefix(ctx)+prefix)
+}
+
+// Prefix returns the config key prefix carried by ctx, or an empty string.
+func Prefix(ctx context.Context) string {
+	prefix, _ := ctx.Value(prefixKey{}).(string)
+	return prefix
+}
@@ -0,0 +1,85 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestWithPrefix(t *testing.T) {
+	type serverConfig struct {
+		Port int    `config:"HTTP_PORT"`
+		Host string `config:"HTTP_HOST" default:"localhost"`
+	}
+
+	tests := map[string]struct {
+		ctx          func() context.Context
+		wantPrefix   string
+		expected     serverConfig
+		expectedKeys []string
+		expectedErr  string
+	}{
+		"no-prefix": {
+			ctx:          context.Background,
+			expected:     serverConfig{Port: 80, Host: "localhost"},
+			expectedKeys: []string{"HTTP_HOST", "HTTP_PORT"},
+		},
+		"prefixed": {
+			ctx:          func() context.Context { return WithPrefix(context.Background(), "ADMIN_") },
+			wantPrefix:   "ADMIN_",
+			expected:     serverConfig{Port: 9090, Host: "admin.local"},
+			expectedKeys: []string{"ADMIN_HTTP_HOST", "ADMIN_HTTP_PORT"},
+		},
+		"nested-prefixes": {
+			ctx: func() context.Context {
+				return WithPrefix(WithPrefix(context.Background(), "APP_"), "ADMIN_")
+			},
+			wantPrefix:  "APP_ADMIN_",
+			expected:    serverConfig{},
+			expectedErr: "config: error getting value for field 'Port': unexpected config lookup for key \"APP_ADMIN_HTTP_PORT\"",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			stub := &stubProvider{}
+			stub.set("HTTP_PORT", "80", nil)
+			stub.set("HTTP_HOST", "", errors.New("key not found"))
+			stub.set("ADMIN_HTTP_PORT", "9090", nil)
+			stub.set("ADMIN_HTTP_HOST", "admin.local", nil)
+			ResetGlobalProvider()
+			defer ResetGlobalProvider()
+			SetGlobalProvider(stub)
+
+			ctx := tt.ctx()
+			if got := Prefix(ctx); got != tt.wantPrefix {
+				t.Fatalf("expected prefix %q, got %q", tt.wantPrefix, got)
+			}
+
+			var got serverConfig
+			err := LoadStruct(ctx, &got)
+			assertErrorMessage(t, err, tt.expectedErr)
+			if got != tt.expected {
+				t.Fatalf("expected %+v, got %+v", tt.expected, got)
+			}
+
+			if tt.expectedErr != "" {
+				return
+			}
+			port, err := Get[int](ctx, "HTTP_PORT")
+			if err != nil || port != tt.expected.Port {
+				t.Fatalf("expected Get to read %d, got %d (%v)", tt.expected.Port, port, err)
+			}
+			var keys []string
+			for _, access := range IntrospectConfigAccesses() {
+				if len(keys) == 0 || keys[len(keys)-1] != access.Key {
+					keys = append(keys, access.Key)
+				}
+			}
+			if !slices.Equal(tt.expectedKeys, keys) {
+				t.Fatalf("expected recorded keys %v, got %v", tt.expectedKeys, keys)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// SecretsDirProvider retrieves configuration values from files in a directory,
+// such as Docker or Kubernetes secrets mounted under /run/secrets.
+// Each key maps to a file of the same name; trailing newlines are trimmed.
+type SecretsDirProvider struct {
+	dir string
+}
+
+// NewSecretsDirProvider creates a provider that reads secrets from files in dir.
+func NewSecretsDirProvider(dir string) SecretsDirProvider {
+	return SecretsDirProvider{dir: dir}
+}
+
+// Get retrieves the contents of the secret file for the given name.
+func (p SecretsDirProvider) Get(ctx context.Context, name string) (string, error) {
+	value, _, err := p.GetWithSource(ctx, name)
+	return value, err
+}
+
+// GetWithSource retrieves the contents of the secret file and reports the file path as its source.
+// A missing file is reported as not found so composite providers and defaults can take over.
+func (p SecretsDirProvider) GetWithSource(_ context.Context, name string) (string, string, error) {
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+		return "", "", fmt.Errorf("invalid secret name '%s'", name)
+	}
+	path := filepath.Join(p.dir, name)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", "", fmt.Errorf("secret '%s' does not exist in '%s': %w", name, p.dir, ErrKeyNotFound)
+		}
+		return "", "", fmt.Errorf("error reading secret '%s': %w", name, err)
+	}
+	return strings.TrimRight(string(data), "\r\n"), path, nil
+}
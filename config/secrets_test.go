@@ -0,0 +1,70 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSecretsDirProvider_GetWithSource(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "DB_PASSWORD"), []byte("s3cr3t\n"), 0o600); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "MULTILINE"), []byte("line1\nline2\r\n"), 0o600); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	tests := map[string]struct {
+		key         string
+		want        string
+		wantSource  string
+		expectedErr string
+	}{
+		"existing_secret": {
+			key:        "DB_PASSWORD",
+			want:       "s3cr3t",
+			wantSource: filepath.Join(dir, "DB_PASSWORD"),
+		},
+		"only_trailing_newlines_trimmed": {
+			key:        "MULTILINE",
+			want:       "line1\nline2",
+			wantSource: filepath.Join(dir, "MULTILINE"),
+		},
+		"missing_secret": {
+			key:         "MISSING",
+			expectedErr: "secret 'MISSING' does not exist in '" + dir + "': key not found",
+		},
+		"path_traversal": {
+			key:         "../DB_PASSWORD",
+			expectedErr: "invalid secret name '../DB_PASSWORD'",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := NewSecretsDirProvider(dir)
+			got, source, err := p.GetWithSource(context.Background(), tt.key)
+			assertErrorMessage(t, err, tt.expectedErr)
+			if got != tt.want {
+				t.Fatalf("expected value %q, got %q", tt.want, got)
+			}
+			if source != tt.wantSource {
+				t.Fatalf("expected source %q, got %q", tt.wantSource, source)
+			}
+		})
+	}
+}
+
+func TestSecretsDirProvider_FallsBackInComposite(t *testing.T) {
+	t.Setenv("API_KEY", "from-env")
+	p := NewCompositeProvider(NewSecretsDirProvider(t.TempDir()), NewEnvVarProvider())
+	got, err := p.Get(context.Background(), "API_KEY")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got != "from-env" {
+		t.Fatalf("expected value %q, got %q", "from-env", got)
+	}
+}
@@ -0,0 +1,77 @@
+package symbiont
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont/depend"
+	"github.com/cleitonmarx/symbiont/internal/reflectx"
+)
+
+const (
+	contextTagName = "context"
+	// resolveTagName is the depend struct tag; an unnamed context.Context field receives the app context
+	resolveTagName = "resolve"
+	// requiredModifier makes a missing context value a wiring error
+	requiredModifier = "required"
+)
+
+// ContextKey is the key type for context values injected into fields tagged context:"name".
+// Initializers store a value with context.WithValue(ctx, symbiont.ContextKey("name"), value) and
+// return the context; components wired afterwards receive it in their tagged fields.
+type ContextKey string
+
+// contextFieldValue returns a struct field iterator that injects context values into fields tagged context:"name".
+// A missing value leaves the field unchanged unless the tag has the required modifier (context:"name,required").
+// A value whose type is not assignable to the field is an error.
+func contextFieldValue(ctx context.Context) reflectx.StructFieldIteratorFunc {
+	return func(fieldValue reflect.Value, structField reflect.StructField, _ reflect.Type) error {
+		tag, ok := structField.Tag.Lookup(contextTagName)
+		if !ok {
+			return nil
+		}
+		name, modifier, _ := strings.Cut(tag, ",")
+		value := ctx.Value(ContextKey(name))
+		if value == nil {
+			if modifier == requiredModifier {
+				return fmt.Errorf("field '%s': context value '%s' was not set", structField.Name, name)
+			}
+			return nil
+		}
+		if !reflect.TypeOf(value).AssignableTo(fieldValue.Type()) {
+			return fmt.Errorf(
+				"field '%s': context value '%s' has type '%s', expected '%s'",
+				structField.Name, name, reflectx.TypeNameOf(value), reflectx.GetTypeName(fieldValue.Type()),
+			)
+		}
+		return reflectx.SetFieldValue(fieldValue, structField, value)
+	}
+}
+
+// appContextFieldValue returns a struct field iterator that injects ctx into context.Context fields
+// tagged resolve:"". The App wires each component with the context built by the initializers that
+// ran before it, so these fields are not resolved from the container.
+func appContextFieldValue(ctx context.Context) reflectx.StructFieldIteratorFunc {
+	return func(fieldValue reflect.Value, structField reflect.StructField, _ reflect.Type) error {
+		if !isAppContextField(fieldValue, structField) {
+			return nil
+		}
+		return reflectx.SetFieldValue(fieldValue, structField, ctx)
+	}
+}
+
+// resolveFieldValue resolves a field from the dependency container, except for app context fields.
+func resolveFieldValue(fieldValue reflect.Value, structField reflect.StructField, targetType reflect.Type) error {
+	if isAppContextField(fieldValue, structField) {
+		return nil
+	}
+	return depend.ResolveStructFieldValue(fieldValue, structField, targetType)
+}
+
+// isAppContextField reports whether a field is a context.Context tagged resolve:"", which receives the app context.
+func isAppContextField(fieldValue reflect.Value, structField reflect.StructField) bool {
+	name, ok := structField.Tag.Lookup(resolveTagName)
+	return ok && name == "" && fieldValue.Type() == reflect.TypeFor[context.Context]()
+}
@@ -0,0 +1,146 @@
+package symbiont
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont/depend"
+)
+
+// contextValueInitializer stores values in the context under ContextKey names.
+type contextValueInitializer struct{ values map[string]any }
+
+func (c *contextValueInitializer) Initialize(ctx context.Context) (context.Context, error) {
+	for k, v := range c.values {
+		ctx = context.WithValue(ctx, ContextKey(k), v)
+	}
+	return ctx, nil
+}
+
+// contextValueRun receives context values through tagged fields.
+type contextValueRun struct {
+	StartupID string `context:"startup_id"`
+	Attempt   int    `context:"attempt"`
+	Err       error  `context:"err"`
+	Untagged  string
+}
+
+func (c *contextValueRun) Run(context.Context) error { return nil }
+
+// requiredContextValueRun fails wiring when its context value is missing.
+type requiredContextValueRun struct {
+	TenantID string `context:"tenant_id,required"`
+}
+
+func (r *requiredContextValueRun) Run(context.Context) error { return nil }
+
+func TestApp_ContextValueInjection(t *testing.T) {
+	tests := map[string]struct {
+		values   map[string]any
+		runnable Runnable
+		validate func(t *testing.T, r Runnable, err error)
+	}{
+		"injects-values": {
+			values:   map[string]any{"startup_id": "abc", "attempt": 3, "err": errors.New("boom")},
+			runnable: &contextValueRun{},
+			validate: func(t *testing.T, r Runnable, err error) {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				got := r.(*contextValueRun)
+				if got.StartupID != "abc" || got.Attempt != 3 || got.Err == nil || got.Err.Error() != "boom" {
+					t.Fatalf("unexpected injected values: %+v", got)
+				}
+			},
+		},
+		"missing-values-are-optional": {
+			runnable: &contextValueRun{StartupID: "preset"},
+			validate: func(t *testing.T, r Runnable, err error) {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				if got := r.(*contextValueRun); got.StartupID != "preset" || got.Attempt != 0 {
+					t.Fatalf("expected fields to be left unchanged, got %+v", got)
+				}
+			},
+		},
+		"missing-required-value": {
+			runnable: &requiredContextValueRun{},
+			validate: func(t *testing.T, _ Runnable, err error) {
+				var se Error
+				if !errors.As(err, &se) || se.Phase != PhaseWiring {
+					t.Fatalf("expected wiring error, got %v", err)
+				}
+				if !strings.Contains(err.Error(), "field 'TenantID': context value 'tenant_id' was not set") {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			},
+		},
+		"type-mismatch": {
+			values:   map[string]any{"attempt": "three"},
+			runnable: &contextValueRun{},
+			validate: func(t *testing.T, _ Runnable, err error) {
+				if err == nil || !strings.Contains(err.Error(), "field 'Attempt': context value 'attempt' has type 'string', expected 'int'") {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			depend.ClearContainer()
+			err := NewApp().
+				Initialize(&contextValueInitializer{values: tt.values}).
+				Host(tt.runnable).
+				RunWithContext(context.Background())
+			tt.validate(t, tt.runnable, err)
+		})
+	}
+}
+
+// appContextRun receives the app context and a context registered in the container.
+type appContextRun struct {
+	AppCtx     context.Context `resolve:""`
+	Registered context.Context `resolve:"background"`
+	runCtx     context.Context
+}
+
+func (r *appContextRun) Run(ctx context.Context) error {
+	r.runCtx = ctx
+	return nil
+}
+
+func TestApp_AppContextInjection(t *testing.T) {
+	depend.ClearContainer()
+	defer depend.ClearContainer()
+	type registeredKey struct{}
+	registered := context.WithValue(context.Background(), registeredKey{}, "registered")
+	depend.RegisterNamed(registered, "background")
+
+	r := &appContextRun{}
+	app := NewApp().
+		Initialize(&contextValueInitializer{values: map[string]any{"auth": "token"}}).
+		Host(r)
+	if err := app.CheckDependencies(); err != nil {
+		t.Fatalf("expected context fields to pass the check, got %v", err)
+	}
+	if err := app.RunWithContext(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if r.AppCtx == nil || r.AppCtx.Value(ContextKey("auth")) != "token" {
+		t.Fatalf("expected the app context carrying initializer values, got %v", r.AppCtx)
+	}
+	if r.AppCtx == r.runCtx {
+		t.Fatal("expected the injected context to differ from the context passed to Run")
+	}
+	if r.runCtx.Err() == nil {
+		t.Fatal("expected the Run context to be cancelled after the app stopped")
+	}
+	if r.Registered != registered {
+		t.Fatalf("expected a named context.Context field to resolve from the container, got %v", r.Registered)
+	}
+}
@@ -4,32 +4,61 @@
 package depend
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"sync"
+	"sync/atomic"
 
 	"github.com/cleitonmarx/symbiont/internal/reflectx"
 	"github.com/cleitonmarx/symbiont/introspection"
 )
 
-const tagName = "resolve"
+const (
+	tagName = "resolve"
+	// allModifier is the resolve tag value that collects every dependency registered for a slice's element type
+	allModifier = "all"
+)
 
 // container is a global map that stores registered dependencies, organized by type and name
 var (
 	containerMu sync.RWMutex
 	container   = make(map[reflect.Type]map[string]any)
+	// registrationOrder keeps the names registered for each type in first-registration order
+	registrationOrder = make(map[reflect.Type][]string)
+	// strictRegistration makes Register and RegisterNamed reject duplicates like the Once variants
+	strictRegistration atomic.Bool
+	// pointerFallback lets a lookup fall back to the pointer or value counterpart of the requested type
+	pointerFallback atomic.Bool
 )
 
+// SetStrictRegistration controls whether Register, RegisterNamed, and RegisterWithConcrete may
+// overwrite an existing registration. When strict, a duplicate registration panics with the
+// error RegisterOnce and RegisterNamedOnce would return, catching accidental double registration
+// without converting every call site. Registration is lenient by default.
+func SetStrictRegistration(strict bool) {
+	strictRegistration.Store(strict)
+}
+
+// SetPointerFallback controls whether resolution falls back to the pointer or value counterpart of the
+// requested type when nothing is registered for the type itself. When enabled, a *log.Logger field can be
+// resolved from a registered log.Logger and a log.Logger field from a registered *log.Logger, under the same
+// name. The resolved value is a copy: a pointer to a copy of the registered value, or a copy of the value a
+// registered pointer points to, so it does not share later changes with the registration.
+// Nil pointers are never dereferenced. The fallback is disabled by default.
+func SetPointerFallback(enabled bool) {
+	pointerFallback.Store(enabled)
+}
+
 // RegisterNamed registers a dependency with an optional name.
 // Multiple dependencies of the same type can be registered with different names.
+// An existing registration is overwritten unless strict registration is enabled.
 func RegisterNamed[T any](dependency T, name string) {
 	typeOfT := reflect.TypeFor[T]()
 	containerMu.Lock()
 	defer containerMu.Unlock()
-	if _, exist := container[typeOfT]; !exist {
-		container[typeOfT] = make(map[string]any)
-	}
-	container[typeOfT][name] = dependency
+	checkStrictRegistration(typeOfT, name)
+	storeDependency(typeOfT, name, dependency)
 
 	if name != "" {
 		logEvent(
@@ -58,20 +87,15 @@ func Register[T any](dependency T) {
 }
 
 // RegisterNamedOnce registers a named dependency, returning an error if already registered.
+// The error includes the file and line of the original registration when it is known.
 func RegisterNamedOnce[T any](dependency T, name string) error {
 	typeOfT := reflect.TypeFor[T]()
 	containerMu.Lock()
 	defer containerMu.Unlock()
-	if _, exist := container[typeOfT]; !exist {
-		container[typeOfT] = make(map[string]any)
-	}
 	if _, exists := container[typeOfT][name]; exists {
-		if name == "" {
-			return fmt.Errorf("depend: dependency already registered for type %s", reflectx.GetTypeName(typeOfT))
-		}
-		return fmt.Errorf("depend: dependency already registered for type %s and name %q", reflectx.GetTypeName(typeOfT), name)
+		return alreadyRegisteredError(reflectx.GetTypeName(typeOfT), name)
 	}
-	container[typeOfT][name] = dependency
+	storeDependency(typeOfT, name, dependency)
 	if name != "" {
 		logEvent(
 			introspection.DepRegistered,
@@ -87,6 +111,9 @@ func RegisterNamedOnce[T any](dependency T, name string) error {
 
 // RegisterOnce registers an unnamed dependency, returning an error if already registered.
 func RegisterOnce[T any](dependency T) error {
+	if err := RegisterNamedOnce(dependency, ""); err != nil {
+		return err
+	}
 	logEvent(
 		introspection.DepRegistered,
 		reflectx.GetTypeName(reflect.TypeFor[T]()),
@@ -95,51 +122,171 @@ func RegisterOnce[T any](dependency T) error {
 		nil,
 		2,
 	)
-	return RegisterNamedOnce(dependency, "")
+	return nil
 }
 
-// ResolveNamed retrieves a registered dependency by type and name.
-func ResolveNamed[T any](name string) (T, error) {
-	emptyType := reflectx.EmptyValue[T]()
+// RegisterWithConcrete registers an unnamed dependency under T and also under its concrete type,
+// so it can be resolved either way without registering it twice:
+//
+//	depend.RegisterWithConcrete[Greeter](PortugueseGreeter{})
+//	g, _ := depend.Resolve[Greeter]()
+//	p, _ := depend.Resolve[PortugueseGreeter]()
+//
+// Both slots are overwritten like Register, and a register event is recorded for each.
+// When T is already the concrete type, it behaves like Register.
+func RegisterWithConcrete[T any](dependency T) {
 	typeOfT := reflect.TypeFor[T]()
-	containerMu.RLock()
-	defer containerMu.RUnlock()
+	concrete := reflect.TypeOf(dependency)
+	containerMu.Lock()
+	defer containerMu.Unlock()
 
-	if dependenciesByName, exist := container[typeOfT]; exist {
-		if dependency, exist := dependenciesByName[name]; exist {
-			if name != "" {
-				logEvent(
-					introspection.DepResolved,
-					reflectx.GetTypeName(typeOfT),
-					name,
-					reflectx.TypeNameOf(dependency),
-					nil,
-					2,
-				)
-			}
-			return dependency.(T), nil
+	checkStrictRegistration(typeOfT, "")
+	if concrete != nil && concrete != typeOfT {
+		checkStrictRegistration(concrete, "")
+	}
+	implName := reflectx.TypeNameOf(dependency)
+	storeDependency(typeOfT, "", dependency)
+	logEvent(
+		introspection.DepRegistered,
+		reflectx.GetTypeName(typeOfT),
+		"",
+		implName,
+		nil,
+		2,
+	)
+
+	if concrete == nil || concrete == typeOfT {
+		return
+	}
+	storeDependency(concrete, "", dependency)
+	logEvent(
+		introspection.DepRegistered,
+		reflectx.GetTypeName(concrete),
+		"",
+		implName,
+		nil,
+		2,
+	)
+}
+
+// RegisterUnique registers a dependency under a name derived from its concrete type and returns that name.
+// If the name is already taken for T, a numeric suffix is appended ("pkg.Impl#2", "pkg.Impl#3", ...),
+// so registrations never overwrite each other. The unnamed slot used by Register and Resolve is not
+// affected; use ResolveAll to collect every implementation.
+func RegisterUnique[T any](dependency T) string {
+	typeOfT := reflect.TypeFor[T]()
+	containerMu.Lock()
+	defer containerMu.Unlock()
+
+	base := reflectx.TypeNameOf(dependency)
+	name := base
+	for i := 2; ; i++ {
+		if _, exists := container[typeOfT][name]; !exists {
+			break
 		}
-		return emptyType, fmt.Errorf("depend: the dependency '%s' of type '%s' was not registered", name, reflectx.GetTypeName(typeOfT))
+		name = fmt.Sprintf("%s#%d", base, i)
 	}
-	return emptyType, fmt.Errorf("depend: the dependency type '%s' was not registered", reflectx.GetTypeName(typeOfT))
+	storeDependency(typeOfT, name, dependency)
+	logEvent(
+		introspection.DepRegistered,
+		reflectx.GetTypeName(typeOfT),
+		name,
+		reflectx.TypeNameOf(dependency),
+		nil,
+		2,
+	)
+	return name
+}
+
+// ResolveNamed retrieves a registered dependency by type and name.
+func ResolveNamed[T any](name string) (T, error) {
+	return resolveNamed[T](name, name != "", 3)
 }
 
 // Resolve retrieves the unnamed registered dependency of the specified type.
 func Resolve[T any]() (T, error) {
-	dep, err := ResolveNamed[T]("")
+	return resolveNamed[T]("", true, 3)
+}
+
+// MustResolveNamed is like ResolveNamed but panics if the dependency is not registered.
+// Intended for program boundaries where a missing dependency is a programming error.
+func MustResolveNamed[T any](name string) T {
+	dep, err := resolveNamed[T](name, name != "", 3)
+	if err != nil {
+		panic(err.Error())
+	}
+	return dep
+}
+
+// MustResolve is like Resolve but panics if the dependency is not registered.
+// Intended for program boundaries where a missing dependency is a programming error.
+func MustResolve[T any]() T {
+	dep, err := resolveNamed[T]("", true, 3)
 	if err != nil {
-		return dep, err
+		panic(err.Error())
 	}
+	return dep
+}
 
+// ResolveOrRegister returns the unnamed dependency of type T, registering the result of factory
+// when none is registered yet. The factory runs without holding the container lock, so it may
+// resolve other dependencies; if another goroutine registers T in the meantime, that registration
+// wins and the factory result is discarded. A register event is recorded only when the factory
+// result is stored; otherwise a resolve event is recorded, as with Resolve.
+func ResolveOrRegister[T any](factory func() T) T {
+	typeOfT := reflect.TypeFor[T]()
+	if dependency, err := resolveNamed[T]("", true, 3); err == nil {
+		return dependency
+	}
+
+	created := factory()
+	containerMu.Lock()
+	defer containerMu.Unlock()
+	if existing, exists := container[typeOfT][""]; exists {
+		logEvent(
+			introspection.DepResolved,
+			reflectx.GetTypeName(typeOfT),
+			"",
+			reflectx.TypeNameOf(existing),
+			nil,
+			2,
+		)
+		return existing.(T)
+	}
+	storeDependency(typeOfT, "", created)
 	logEvent(
-		introspection.DepResolved,
-		reflectx.GetTypeName(reflect.TypeFor[T]()),
+		introspection.DepRegistered,
+		reflectx.GetTypeName(typeOfT),
 		"",
-		reflectx.TypeNameOf(dep),
+		reflectx.TypeNameOf(created),
 		nil,
 		2,
 	)
-	return dep, nil
+	return created
+}
+
+// ResolveAll retrieves every dependency registered for type T, named and unnamed, in registration order.
+// Returns an empty non-nil slice when nothing is registered for T.
+func ResolveAll[T any]() []T {
+	typeOfT := reflect.TypeFor[T]()
+	containerMu.RLock()
+	defer containerMu.RUnlock()
+
+	names := registrationOrder[typeOfT]
+	all := make([]T, 0, len(names))
+	for _, name := range names {
+		dependency := container[typeOfT][name]
+		logEvent(
+			introspection.DepResolved,
+			reflectx.GetTypeName(typeOfT),
+			name,
+			reflectx.TypeNameOf(dependency),
+			nil,
+			2,
+		)
+		all = append(all, dependency.(T))
+	}
+	return all
 }
 
 // ResolveStruct injects dependencies into all struct fields tagged with resolve:"name".
@@ -149,21 +296,71 @@ func ResolveStruct[T any](target *T) error {
 
 // ResolveStructFieldValue injects a dependency into a single struct field based on its resolve tag.
 // Used internally during struct field injection; resolves by field type and tag value.
+// A slice-of-interface field tagged resolve:"all" receives every dependency registered for
+// the slice's element type, in registration order, and a slice field tagged resolve:"group:name"
+// receives the members of that group. A Lazy field is bound without reading the container.
 func ResolveStructFieldValue(fieldValue reflect.Value, structField reflect.StructField, targetType reflect.Type) error {
 	dependencyName, ok := structField.Tag.Lookup(tagName)
 	if !ok {
 		return nil
 	}
+	if lazy, ok := asLazyField(fieldValue.Type()); ok {
+		if err := reflectx.SetFieldValue(fieldValue, structField, lazy.bind(dependencyName, targetType)); err != nil {
+			return fmt.Errorf("depend: %s", err)
+		}
+		return nil
+	}
 	containerMu.RLock()
 	defer containerMu.RUnlock()
 
-	dependenciesByName, typeExist := container[fieldValue.Type()]
-	if !typeExist {
-		return fmt.Errorf("depend: the dependency type '%s' was not registered", reflectx.GetTypeName(fieldValue.Type()))
+	if group, ok := groupName(dependencyName); ok {
+		members, err := lookupGroup(fieldValue.Type(), group)
+		if err != nil {
+			return err
+		}
+		if err := reflectx.SetFieldValue(fieldValue, structField, groupSlice(fieldValue.Type(), members).Interface()); err != nil {
+			return fmt.Errorf("depend: %s", err)
+		}
+		for _, m := range members {
+			logEvent(
+				introspection.DepResolved,
+				reflectx.GetTypeName(m.typ),
+				dependencyName,
+				reflectx.TypeNameOf(m.dependency),
+				targetType,
+				5,
+			)
+		}
+		return nil
 	}
-	dependency, nameExist := dependenciesByName[dependencyName]
-	if !nameExist {
-		return fmt.Errorf("depend: the dependency '%s' of type '%s' was not registered", dependencyName, reflectx.GetTypeName(fieldValue.Type()))
+
+	if isCollectAllField(fieldValue.Type(), dependencyName) {
+		elemType := fieldValue.Type().Elem()
+		names := registrationOrder[elemType]
+		all := reflect.MakeSlice(fieldValue.Type(), 0, len(names))
+		for _, name := range names {
+			all = reflect.Append(all, reflect.ValueOf(container[elemType][name]))
+		}
+		if err := reflectx.SetFieldValue(fieldValue, structField, all.Interface()); err != nil {
+			return fmt.Errorf("depend: %s", err)
+		}
+		for _, name := range names {
+			dependency := container[elemType][name]
+			logEvent(
+				introspection.DepResolved,
+				reflectx.GetTypeName(elemType),
+				name,
+				reflectx.TypeNameOf(dependency),
+				targetType,
+				5,
+			)
+		}
+		return nil
+	}
+
+	dependency, err := lookupFieldDependency(fieldValue.Type(), dependencyName)
+	if err != nil {
+		return err
 	}
 	if err := reflectx.SetFieldValue(fieldValue, structField, dependency); err != nil {
 		return fmt.Errorf("depend: %s", err)
@@ -180,6 +377,159 @@ func ResolveStructFieldValue(fieldValue reflect.Value, structField reflect.Struc
 	return nil
 }
 
+// CheckStructFieldValue verifies that the dependency referenced by a field's resolve tag is registered.
+// Unlike ResolveStructFieldValue, it neither assigns the field nor records a resolution event.
+func CheckStructFieldValue(fieldValue reflect.Value, structField reflect.StructField, _ reflect.Type) error {
+	dependencyName, ok := structField.Tag.Lookup(tagName)
+	if !ok {
+		return nil
+	}
+	if isCollectAllField(fieldValue.Type(), dependencyName) {
+		return nil
+	}
+	if _, ok := asLazyField(fieldValue.Type()); ok {
+		// Lazy fields are resolved on use, so their dependency may be registered later.
+		return nil
+	}
+	containerMu.RLock()
+	defer containerMu.RUnlock()
+
+	if group, ok := groupName(dependencyName); ok {
+		_, err := lookupGroup(fieldValue.Type(), group)
+		return err
+	}
+
+	_, err := lookupFieldDependency(fieldValue.Type(), dependencyName)
+	return err
+}
+
+// alreadyRegisteredError builds the duplicate registration error, pointing at the original registration if known.
+func alreadyRegisteredError(typeName, name string) error {
+	msg := fmt.Sprintf("depend: dependency already registered for type %s", typeName)
+	if name != "" {
+		msg += fmt.Sprintf(" and name %q", name)
+	}
+	if caller, ok := lastRegistration(typeName, name); ok {
+		msg += fmt.Sprintf(" at %s:%d", caller.File, caller.Line)
+	}
+	return errors.New(msg)
+}
+
+// checkStrictRegistration panics if strict registration is enabled and the type and name are taken.
+// The caller must hold containerMu.
+func checkStrictRegistration(typeOfT reflect.Type, name string) {
+	if err := strictRegistrationError(typeOfT, name); err != nil {
+		panic(err.Error())
+	}
+}
+
+// strictRegistrationError returns the duplicate registration error if strict registration is enabled
+// and the type and name are taken. The caller must hold containerMu, for reading at least.
+func strictRegistrationError(typeOfT reflect.Type, name string) error {
+	if !strictRegistration.Load() {
+		return nil
+	}
+	if _, exists := container[typeOfT][name]; exists {
+		return alreadyRegisteredError(reflectx.GetTypeName(typeOfT), name)
+	}
+	return nil
+}
+
+// resolveNamed looks up a dependency by type and name, recording a resolution event when logResolve is set.
+// The level identifies the caller frame attributed in the event.
+func resolveNamed[T any](name string, logResolve bool, level int) (T, error) {
+	typeOfT := reflect.TypeFor[T]()
+	containerMu.RLock()
+	defer containerMu.RUnlock()
+
+	dependency, err := lookupFieldDependency(typeOfT, name)
+	if err != nil {
+		return reflectx.EmptyValue[T](), err
+	}
+	if logResolve {
+		logEvent(
+			introspection.DepResolved,
+			reflectx.GetTypeName(typeOfT),
+			name,
+			reflectx.TypeNameOf(dependency),
+			nil,
+			level,
+		)
+	}
+	return dependency.(T), nil
+}
+
+// isCollectAllField reports whether a field is a slice of interfaces tagged with the all modifier.
+func isCollectAllField(fieldType reflect.Type, dependencyName string) bool {
+	return dependencyName == allModifier &&
+		fieldType.Kind() == reflect.Slice &&
+		fieldType.Elem().Kind() == reflect.Interface
+}
+
+// storeDependency stores a dependency and tracks its first registration order.
+// The caller must hold containerMu for writing.
+func storeDependency(typeOfT reflect.Type, name string, dependency any) {
+	if _, exist := container[typeOfT]; !exist {
+		container[typeOfT] = make(map[string]any)
+	}
+	if _, exist := container[typeOfT][name]; !exist {
+		registrationOrder[typeOfT] = append(registrationOrder[typeOfT], name)
+	}
+	container[typeOfT][name] = dependency
+}
+
+// lookupFieldDependency finds a registered dependency by type and name, falling back to the
+// pointer or value counterpart of the type when SetPointerFallback is enabled.
+// The caller must hold containerMu.
+func lookupFieldDependency(fieldType reflect.Type, dependencyName string) (any, error) {
+	dependency, err := lookupExactDependency(fieldType, dependencyName)
+	if err == nil || !pointerFallback.Load() {
+		return dependency, err
+	}
+	if dependency, ok := lookupCounterpartDependency(fieldType, dependencyName); ok {
+		return dependency, nil
+	}
+	return nil, err
+}
+
+// lookupCounterpartDependency converts the dependency registered for the pointer or value counterpart
+// of fieldType. It reports false when nothing usable is registered.
+// The caller must hold containerMu.
+func lookupCounterpartDependency(fieldType reflect.Type, dependencyName string) (any, bool) {
+	if fieldType.Kind() == reflect.Pointer {
+		dependency, err := lookupExactDependency(fieldType.Elem(), dependencyName)
+		if err != nil || dependency == nil {
+			return nil, false
+		}
+		ptr := reflect.New(fieldType.Elem())
+		ptr.Elem().Set(reflect.ValueOf(dependency))
+		return ptr.Interface(), true
+	}
+	dependency, err := lookupExactDependency(reflect.PointerTo(fieldType), dependencyName)
+	if err != nil {
+		return nil, false
+	}
+	v := reflect.ValueOf(dependency)
+	if !v.IsValid() || v.IsNil() {
+		return nil, false
+	}
+	return v.Elem().Interface(), true
+}
+
+// lookupExactDependency finds a dependency registered for exactly the given type and name.
+// The caller must hold containerMu.
+func lookupExactDependency(fieldType reflect.Type, dependencyName string) (any, error) {
+	dependenciesByName, typeExist := container[fieldType]
+	if !typeExist {
+		return nil, fmt.Errorf("depend: the dependency type '%s' was not registered%s", reflectx.GetTypeName(fieldType), registeredTypesHint(fieldType))
+	}
+	dependency, nameExist := dependenciesByName[dependencyName]
+	if !nameExist {
+		return nil, fmt.Errorf("depend: the dependency '%s' of type '%s' was not registered%s", dependencyName, reflectx.GetTypeName(fieldType), registeredNamesHint(fieldType))
+	}
+	return dependency, nil
+}
+
 // ClearContainer removes all registered dependencies and clears the event log.
 // Typically used in tests to isolate dependency registrations between test cases.
 func ClearContainer() {
@@ -189,5 +539,7 @@ func ClearContainer() {
 	defer eventMu.Unlock()
 
 	container = make(map[reflect.Type]map[string]any)
+	registrationOrder = make(map[reflect.Type][]string)
+	groups = make(map[string][]groupMember)
 	events = make([]introspection.DepEvent, 0)
 }
@@ -1,8 +1,15 @@
 package depend
 
 import (
+	"context"
+	"fmt"
 	"reflect"
+	"runtime"
+	"strings"
 	"testing"
+
+	"github.com/cleitonmarx/symbiont/internal/reflectx"
+	"github.com/cleitonmarx/symbiont/introspection"
 )
 
 type Greeter interface {
@@ -65,7 +72,7 @@ func TestResolveNamed(t *testing.T) {
 				return ResolveNamed[Greeter]("nonexistent")
 			},
 			expectedValue: nil,
-			expectedErr:   "depend: the dependency 'nonexistent' of type 'depend.Greeter' was not registered",
+			expectedErr:   "depend: the dependency 'nonexistent' of type 'depend.Greeter' was not registered; registered names: [\"englishGreeter\", \"spanishGreeter\"]",
 		},
 	}
 
@@ -139,13 +146,15 @@ func TestRegisterNamedOnce_Greeter(t *testing.T) {
 	ClearContainer()
 
 	err := RegisterNamedOnce[Greeter](EnglishGreeter{}, "english")
+	_, _, line, _ := runtime.Caller(0)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
 
 	err = RegisterNamedOnce[Greeter](PortugueseGreeter{}, "english")
-	if err == nil || err.Error() != `depend: dependency already registered for type depend.Greeter and name "english"` {
-		t.Fatalf("expected duplicate registration error, got %v", err)
+	want := fmt.Sprintf(`depend: dependency already registered for type depend.Greeter and name "english" at depend/container_test.go:%d`, line-1)
+	if err == nil || err.Error() != want {
+		t.Fatalf("expected duplicate registration error %q, got %v", want, err)
 	}
 
 	err = RegisterNamedOnce[Greeter](PortugueseGreeter{}, "portuguese")
@@ -174,13 +183,25 @@ func TestRegisterOnce_Greeter(t *testing.T) {
 	ClearContainer()
 
 	err := RegisterOnce[Greeter](EnglishGreeter{})
+	_, _, line, _ := runtime.Caller(0)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
 
 	err = RegisterOnce[Greeter](PortugueseGreeter{})
-	if err == nil || err.Error() != "depend: dependency already registered for type depend.Greeter" {
-		t.Fatalf("expected duplicate registration error, got %v", err)
+	want := fmt.Sprintf("depend: dependency already registered for type depend.Greeter at depend/container_test.go:%d", line-1)
+	if err == nil || err.Error() != want {
+		t.Fatalf("expected duplicate registration error %q, got %v", want, err)
+	}
+
+	registrations := 0
+	for _, ev := range GetEvents() {
+		if ev.Kind == introspection.DepRegistered {
+			registrations++
+		}
+	}
+	if registrations != 1 {
+		t.Fatalf("expected rejected registration to record no event, got %d registrations", registrations)
 	}
 
 	g, err := Resolve[Greeter]()
@@ -212,6 +233,15 @@ func TestResolveStruct(t *testing.T) {
 		testNotSettable struct {
 			notSettableField int `resolve:""`
 		}
+
+		embeddedGreeters struct {
+			EnglishGreeter Greeter `resolve:"englishGreeter"`
+		}
+		testEmbedded struct {
+			embeddedGreeters
+			Greeter `resolve:"portugueseGreeter"`
+			Answer  int `resolve:""`
+		}
 	)
 
 	// Register dependencies
@@ -232,10 +262,18 @@ func TestResolveStruct(t *testing.T) {
 				PortugueseGreeter: PortugueseGreeter{},
 			},
 		},
+		"resolve_promoted_fields_of_embedded_struct": {
+			target: &testEmbedded{},
+			expected: testEmbedded{
+				embeddedGreeters: embeddedGreeters{EnglishGreeter: EnglishGreeter{}},
+				Greeter:          PortugueseGreeter{},
+				Answer:           42,
+			},
+		},
 		"error_resolving_missing_dependency": {
 			target:      &testMissingType{},
 			expected:    testMissingType{},
-			expectedErr: "depend: the dependency '' of type 'depend.Greeter' was not registered",
+			expectedErr: "depend: the dependency '' of type 'depend.Greeter' was not registered; registered names: [\"englishGreeter\", \"portugueseGreeter\"]",
 		},
 		"error_resolving_missing_named_dependency": {
 			target:      &testMissingNamed{},
@@ -263,6 +301,8 @@ func TestResolveStruct(t *testing.T) {
 				resolveStructAndAssert(t, target, tc.expected.(testMissingType), tc.expectedErr)
 			case *testMissingNamed:
 				resolveStructAndAssert(t, target, tc.expected.(testMissingNamed), tc.expectedErr)
+			case *testEmbedded:
+				resolveStructAndAssert(t, target, tc.expected.(testEmbedded), tc.expectedErr)
 			case *testNotSettable:
 				_ = target.notSettableField // Use the field to avoid unused warning
 				resolveStructAndAssert(t, target, tc.expected.(testNotSettable), tc.expectedErr)
@@ -298,3 +338,452 @@ func resolveStructAndAssert[T any](t *testing.T, target *T, expected T, expected
 		t.Fatalf("expected value %#v, got %#v", expected, *target)
 	}
 }
+
+func TestResolveAll(t *testing.T) {
+	ClearContainer()
+
+	if got := ResolveAll[Greeter](); got == nil || len(got) != 0 {
+		t.Fatalf("expected empty non-nil slice, got %#v", got)
+	}
+
+	RegisterNamed[Greeter](PortugueseGreeter{}, "portuguese")
+	Register[Greeter](EnglishGreeter{})
+	RegisterNamed[Greeter](EnglishGreeter{}, "english")
+	// overwriting keeps the original registration position
+	RegisterNamed[Greeter](PortugueseGreeter{}, "portuguese")
+	Register(EnglishGreeter{})
+
+	want := []Greeter{PortugueseGreeter{}, EnglishGreeter{}, EnglishGreeter{}}
+	if got := ResolveAll[Greeter](); !reflect.DeepEqual(want, got) {
+		t.Fatalf("expected %#v, got %#v", want, got)
+	}
+}
+
+func TestResolveStruct_All(t *testing.T) {
+	type (
+		plugins struct {
+			Greeters []Greeter `resolve:"all"`
+		}
+		namedSlice struct {
+			Names []string `resolve:"all"`
+		}
+	)
+
+	tests := map[string]struct {
+		register    func()
+		target      any
+		expected    any
+		expectedErr string
+	}{
+		"collects_in_registration_order": {
+			register: func() {
+				RegisterNamed[Greeter](EnglishGreeter{}, "english")
+				RegisterNamed[Greeter](PortugueseGreeter{}, "portuguese")
+			},
+			target:   &plugins{},
+			expected: &plugins{Greeters: []Greeter{EnglishGreeter{}, PortugueseGreeter{}}},
+		},
+		"empty_non_nil_slice_when_nothing_registered": {
+			target:   &plugins{},
+			expected: &plugins{Greeters: []Greeter{}},
+		},
+		"non_interface_slice_uses_name_lookup": {
+			register: func() {
+				RegisterNamed([]string{"a"}, "all")
+			},
+			target:   &namedSlice{},
+			expected: &namedSlice{Names: []string{"a"}},
+		},
+		"non_interface_slice_not_registered": {
+			target:      &namedSlice{},
+			expected:    &namedSlice{},
+			expectedErr: "depend: the dependency type '[]string' was not registered",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ClearContainer()
+			if tc.register != nil {
+				tc.register()
+			}
+			switch target := tc.target.(type) {
+			case *plugins:
+				resolveStructAndAssert(t, target, *tc.expected.(*plugins), tc.expectedErr)
+			case *namedSlice:
+				resolveStructAndAssert(t, target, *tc.expected.(*namedSlice), tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestMustResolve(t *testing.T) {
+	ClearContainer()
+	Register[Greeter](EnglishGreeter{})
+	RegisterNamed[Greeter](PortugueseGreeter{}, "pt")
+
+	tests := map[string]struct {
+		resolveFunc   func() Greeter
+		expectedGreet string
+		expectedPanic string
+	}{
+		"must_resolve": {
+			resolveFunc:   func() Greeter { return MustResolve[Greeter]() },
+			expectedGreet: "Hello!",
+		},
+		"must_resolve_named": {
+			resolveFunc:   func() Greeter { return MustResolveNamed[Greeter]("pt") },
+			expectedGreet: "Olá!",
+		},
+		"must_resolve_missing_type": {
+			resolveFunc:   func() Greeter { MustResolve[fmt.Stringer](); return nil },
+			expectedPanic: "depend: the dependency type 'fmt.Stringer' was not registered",
+		},
+		"must_resolve_named_missing_name": {
+			resolveFunc:   func() Greeter { return MustResolveNamed[Greeter]("es") },
+			expectedPanic: "depend: the dependency 'es' of type 'depend.Greeter' was not registered; registered names: [\"\", \"pt\"]",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+				if tc.expectedPanic == "" && r != nil {
+					t.Fatalf("unexpected panic: %v", r)
+				}
+				if tc.expectedPanic != "" && r != tc.expectedPanic {
+					t.Fatalf("expected panic %q, got %v", tc.expectedPanic, r)
+				}
+			}()
+			if got := tc.resolveFunc(); got.Greet() != tc.expectedGreet {
+				t.Fatalf("expected %q, got %q", tc.expectedGreet, got.Greet())
+			}
+		})
+	}
+
+	resolved := 0
+	for _, ev := range GetEvents() {
+		if ev.Kind != introspection.DepResolved {
+			continue
+		}
+		resolved++
+		if !strings.Contains(ev.Caller.Func, "TestMustResolve") {
+			t.Fatalf("expected resolve event attributed to the test, got %q", ev.Caller.Func)
+		}
+	}
+	if resolved != 2 {
+		t.Fatalf("expected 2 resolve events, got %d", resolved)
+	}
+}
+
+func TestRegisterUnique(t *testing.T) {
+	ClearContainer()
+	Register[Greeter](PortugueseGreeter{})
+
+	names := []string{
+		RegisterUnique[Greeter](EnglishGreeter{}),
+		RegisterUnique[Greeter](PortugueseGreeter{}),
+		RegisterUnique[Greeter](EnglishGreeter{}),
+	}
+	wantNames := []string{"depend.EnglishGreeter", "depend.PortugueseGreeter", "depend.EnglishGreeter#2"}
+	if !reflect.DeepEqual(wantNames, names) {
+		t.Fatalf("expected names %v, got %v", wantNames, names)
+	}
+
+	for i, name := range names {
+		if _, err := ResolveNamed[Greeter](name); err != nil {
+			t.Fatalf("expected registration %d to resolve, got %v", i, err)
+		}
+	}
+	if got, _ := Resolve[Greeter](); got.Greet() != "Olá!" {
+		t.Fatalf("expected unnamed slot to be untouched, got %q", got.Greet())
+	}
+	if got := ResolveAll[Greeter](); len(got) != 4 {
+		t.Fatalf("expected 4 greeters, got %d", len(got))
+	}
+}
+
+func TestResolveOrRegister(t *testing.T) {
+	tests := map[string]struct {
+		register     func()
+		expected     string
+		factoryCalls int
+		wantKinds    []introspection.DepEventKind
+	}{
+		"registers_factory_result_when_missing": {
+			expected:     "Hello!",
+			factoryCalls: 1,
+			wantKinds:    []introspection.DepEventKind{introspection.DepRegistered},
+		},
+		"returns_existing_registration": {
+			register:  func() { Register[Greeter](PortugueseGreeter{}) },
+			expected:  "Olá!",
+			wantKinds: []introspection.DepEventKind{introspection.DepRegistered, introspection.DepResolved},
+		},
+		"named_registration_does_not_count": {
+			register:     func() { RegisterNamed[Greeter](PortugueseGreeter{}, "portuguese") },
+			expected:     "Hello!",
+			factoryCalls: 1,
+			wantKinds:    []introspection.DepEventKind{introspection.DepRegistered, introspection.DepRegistered},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ClearContainer()
+			if tt.register != nil {
+				tt.register()
+			}
+
+			calls := 0
+			factory := func() Greeter {
+				calls++
+				return EnglishGreeter{}
+			}
+			got := ResolveOrRegister(factory)
+			if got.Greet() != tt.expected {
+				t.Fatalf("expected %q, got %q", tt.expected, got.Greet())
+			}
+			if again := ResolveOrRegister(factory); again.Greet() != tt.expected {
+				t.Fatalf("expected the same registration on the second call, got %q", again.Greet())
+			}
+			if calls != tt.factoryCalls {
+				t.Fatalf("expected %d factory calls, got %d", tt.factoryCalls, calls)
+			}
+
+			var kinds []introspection.DepEventKind
+			for _, ev := range GetEvents() {
+				kinds = append(kinds, ev.Kind)
+			}
+			// the second call always resolves the existing registration
+			wantKinds := append(tt.wantKinds, introspection.DepResolved)
+			if !reflect.DeepEqual(wantKinds, kinds) {
+				t.Fatalf("expected events %v, got %v", wantKinds, kinds)
+			}
+		})
+	}
+}
+
+func TestRegisterWithConcrete(t *testing.T) {
+	ClearContainer()
+	RegisterWithConcrete[Greeter](PortugueseGreeter{})
+
+	if g, err := Resolve[Greeter](); err != nil || g.Greet() != "Olá!" {
+		t.Fatalf("expected interface slot to resolve, got %v, %v", g, err)
+	}
+	if p, err := Resolve[PortugueseGreeter](); err != nil || p.Greet() != "Olá!" {
+		t.Fatalf("expected concrete slot to resolve, got %v, %v", p, err)
+	}
+
+	var registered []string
+	for _, ev := range GetEvents() {
+		if ev.Kind == introspection.DepRegistered {
+			registered = append(registered, ev.Type+" -> "+ev.Impl)
+		}
+	}
+	want := []string{"depend.Greeter -> depend.PortugueseGreeter", "depend.PortugueseGreeter -> depend.PortugueseGreeter"}
+	if !reflect.DeepEqual(want, registered) {
+		t.Fatalf("expected register events %v, got %v", want, registered)
+	}
+
+	// Plain Register keeps the slots distinct.
+	ClearContainer()
+	Register[Greeter](PortugueseGreeter{})
+	if _, err := Resolve[PortugueseGreeter](); err == nil {
+		t.Fatal("expected concrete slot to stay empty after Register")
+	}
+}
+
+func TestSetStrictRegistration(t *testing.T) {
+	defer SetStrictRegistration(false)
+
+	tests := map[string]struct {
+		register      func()
+		expectedPanic string
+	}{
+		"register-duplicate": {
+			register:      func() { Register[Greeter](PortugueseGreeter{}) },
+			expectedPanic: "depend: dependency already registered for type depend.Greeter at ",
+		},
+		"register-named-duplicate": {
+			register:      func() { RegisterNamed[Greeter](PortugueseGreeter{}, "en") },
+			expectedPanic: `depend: dependency already registered for type depend.Greeter and name "en" at `,
+		},
+		"register-with-concrete-duplicate": {
+			register:      func() { RegisterWithConcrete[Greeter](PortugueseGreeter{}) },
+			expectedPanic: "depend: dependency already registered for type depend.Greeter at ",
+		},
+		"register-named-new-name": {
+			register: func() { RegisterNamed[Greeter](PortugueseGreeter{}, "pt") },
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ClearContainer()
+			Register[Greeter](EnglishGreeter{})
+			RegisterNamed[Greeter](EnglishGreeter{}, "en")
+			SetStrictRegistration(true)
+			defer SetStrictRegistration(false)
+
+			func() {
+				defer func() {
+					r := recover()
+					if tc.expectedPanic == "" && r != nil {
+						t.Fatalf("unexpected panic: %v", r)
+					}
+					if tc.expectedPanic != "" {
+						msg, _ := r.(string)
+						if !strings.HasPrefix(msg, tc.expectedPanic) || !strings.Contains(msg, "container_test.go:") {
+							t.Fatalf("expected panic starting with %q pointing at the original registration, got %v", tc.expectedPanic, r)
+						}
+					}
+				}()
+				tc.register()
+			}()
+
+			// A rejected registration leaves the original in place.
+			if g, err := Resolve[Greeter](); err != nil || g.Greet() != "Hello!" {
+				t.Fatalf("expected original registration to be kept, got %v, %v", g, err)
+			}
+		})
+	}
+
+	ClearContainer()
+	Register[Greeter](EnglishGreeter{})
+	Register[Greeter](PortugueseGreeter{})
+	if g, _ := Resolve[Greeter](); g.Greet() != "Olá!" {
+		t.Fatalf("expected lenient registration to overwrite, got %q", g.Greet())
+	}
+}
+
+type fallbackConfig struct {
+	Name string
+}
+
+func TestSetPointerFallback(t *testing.T) {
+	defer SetPointerFallback(false)
+
+	type pointerTarget struct {
+		Config *fallbackConfig `resolve:""`
+	}
+	type valueTarget struct {
+		Config fallbackConfig `resolve:"named"`
+	}
+
+	tests := map[string]struct {
+		register    func()
+		fallback    bool
+		resolve     func() (string, error)
+		expected    string
+		expectedErr string
+	}{
+		"pointer-from-value": {
+			register: func() { Register(fallbackConfig{Name: "value"}) },
+			fallback: true,
+			resolve: func() (string, error) {
+				c, err := Resolve[*fallbackConfig]()
+				if err != nil {
+					return "", err
+				}
+				return c.Name, nil
+			},
+			expected: "value",
+		},
+		"value-from-pointer": {
+			register: func() { Register(&fallbackConfig{Name: "pointer"}) },
+			fallback: true,
+			resolve: func() (string, error) {
+				c, err := Resolve[fallbackConfig]()
+				return c.Name, err
+			},
+			expected: "pointer",
+		},
+		"pointer-field-from-value": {
+			register: func() { Register(fallbackConfig{Name: "field"}) },
+			fallback: true,
+			resolve: func() (string, error) {
+				var target pointerTarget
+				if err := ResolveStruct(&target); err != nil {
+					return "", err
+				}
+				return target.Config.Name, nil
+			},
+			expected: "field",
+		},
+		"named-value-field-from-pointer": {
+			register: func() { RegisterNamed(&fallbackConfig{Name: "named"}, "named") },
+			fallback: true,
+			resolve: func() (string, error) {
+				var target valueTarget
+				err := ResolveStruct(&target)
+				return target.Config.Name, err
+			},
+			expected: "named",
+		},
+		"nil-pointer-is-not-dereferenced": {
+			register: func() { Register[*fallbackConfig](nil) },
+			fallback: true,
+			resolve: func() (string, error) {
+				c, err := Resolve[fallbackConfig]()
+				return c.Name, err
+			},
+			expectedErr: "depend: the dependency type 'depend.fallbackConfig' was not registered; did you mean: [*depend.fallbackConfig]",
+		},
+		"disabled-by-default": {
+			register: func() { Register(fallbackConfig{Name: "value"}) },
+			resolve: func() (string, error) {
+				_, err := Resolve[*fallbackConfig]()
+				return "", err
+			},
+			expectedErr: "depend: the dependency type '*depend.fallbackConfig' was not registered; did you mean: [depend.fallbackConfig]",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ClearContainer()
+			SetPointerFallback(tc.fallback)
+			defer SetPointerFallback(false)
+			tc.register()
+
+			got, err := tc.resolve()
+			assertErrorMessage(t, err, tc.expectedErr)
+			if got != tc.expected {
+				t.Fatalf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+
+	// The resolved pointer points to a copy of the registered value.
+	ClearContainer()
+	SetPointerFallback(true)
+	Register(fallbackConfig{Name: "original"})
+	c := MustResolve[*fallbackConfig]()
+	c.Name = "changed"
+	if v := MustResolve[fallbackConfig](); v.Name != "original" {
+		t.Fatalf("expected registration to be unaffected, got %q", v.Name)
+	}
+}
+
+func TestResolveStruct_ContextField(t *testing.T) {
+	ClearContainer()
+	type withContext struct {
+		Ctx     context.Context `resolve:""`
+		Greeter Greeter         `resolve:""`
+	}
+	Register[Greeter](EnglishGreeter{})
+
+	var target withContext
+	err := reflectx.IterateStructFields(&target, CheckStructFieldValue)
+	if err == nil || !strings.Contains(err.Error(), "context.Context") {
+		t.Fatalf("expected an unregistered context.Context to fail the check, got %v", err)
+	}
+
+	ctx := context.WithValue(context.Background(), ctxKey{}, "registered")
+	Register(ctx)
+	resolveStructAndAssert(t, &target, withContext{Ctx: ctx, Greeter: EnglishGreeter{}}, "")
+}
+
+type ctxKey struct{}
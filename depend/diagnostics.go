@@ -0,0 +1,131 @@
+package depend
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"github.com/cleitonmarx/symbiont/internal/reflectx"
+)
+
+// maxSuggestions bounds the registered types suggested when a lookup fails.
+const maxSuggestions = 3
+
+// verboseErrors makes resolution errors list every registered dependency instead of the closest matches
+var verboseErrors atomic.Bool
+
+// SetVerboseErrors controls how much a failed resolution reports about the container. By default an error
+// for an unregistered type suggests up to three registered types with similar names, such as
+// "*log.Logger" for "log.Logger". When verbose, it lists every registered type and name instead, which
+// can be long in large applications. An error for an unregistered name always lists the names
+// registered for its type.
+func SetVerboseErrors(enabled bool) {
+	verboseErrors.Store(enabled)
+}
+
+// registeredNamesHint lists the names registered for a type, for an error about a missing name.
+// The caller must hold containerMu.
+func registeredNamesHint(fieldType reflect.Type) string {
+	names := make([]string, 0, len(registrationOrder[fieldType]))
+	for _, name := range registrationOrder[fieldType] {
+		names = append(names, fmt.Sprintf("%q", name))
+	}
+	return fmt.Sprintf("; registered names: [%s]", strings.Join(names, ", "))
+}
+
+// registeredTypesHint describes the registered types for an error about a missing type: every
+// registration when verbose errors are enabled, otherwise the types whose names resemble it.
+// Returns an empty string when there is nothing to suggest. The caller must hold containerMu.
+func registeredTypesHint(fieldType reflect.Type) string {
+	if verboseErrors.Load() {
+		var available []string
+		for t, names := range registrationOrder {
+			for _, name := range names {
+				entry := reflectx.GetTypeName(t)
+				if name != "" {
+					entry += fmt.Sprintf(" %q", name)
+				}
+				available = append(available, entry)
+			}
+		}
+		if len(available) == 0 {
+			return ""
+		}
+		sort.Strings(available)
+		return fmt.Sprintf("; available: [%s]", strings.Join(available, ", "))
+	}
+
+	want := baseTypeName(reflectx.GetTypeName(fieldType))
+	type candidate struct {
+		name  string
+		score int
+	}
+	var candidates []candidate
+	for t := range container {
+		name := reflectx.GetTypeName(t)
+		if score, ok := similarity(want, baseTypeName(name)); ok {
+			candidates = append(candidates, candidate{name: name, score: score})
+		}
+	}
+	if len(candidates) == 0 {
+		return ""
+	}
+	sort.Slice(candidates, func(a, b int) bool {
+		if candidates[a].score == candidates[b].score {
+			return candidates[a].name < candidates[b].name
+		}
+		return candidates[a].score < candidates[b].score
+	})
+	suggestions := make([]string, 0, maxSuggestions)
+	for i := 0; i < len(candidates) && i < maxSuggestions; i++ {
+		suggestions = append(suggestions, candidates[i].name)
+	}
+	return fmt.Sprintf("; did you mean: [%s]", strings.Join(suggestions, ", "))
+}
+
+// baseTypeName lowercases a type name and strips its pointer, slice, and package qualifiers,
+// so "*log.Logger" and "[]log.Logger" both compare as "logger".
+func baseTypeName(typeName string) string {
+	typeName = strings.TrimLeft(typeName, "*[]")
+	if i := strings.LastIndex(typeName, "."); i >= 0 {
+		typeName = typeName[i+1:]
+	}
+	return strings.ToLower(typeName)
+}
+
+// similarity scores how closely two base type names match, lower being closer. Names match when one
+// contains the other or their edit distance is at most a third of the shorter name.
+func similarity(a, b string) (int, bool) {
+	if a == b {
+		return 0, true
+	}
+	if strings.Contains(a, b) || strings.Contains(b, a) {
+		return 1, true
+	}
+	d := levenshtein(a, b)
+	limit := max(1, min(len(a), len(b))/3)
+	return d + 1, d <= limit
+}
+
+// levenshtein returns the edit distance between two strings.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
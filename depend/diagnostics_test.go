@@ -0,0 +1,109 @@
+package depend
+
+import (
+	"log"
+	"testing"
+)
+
+type (
+	AppMetadata    struct{}
+	appMetadataV2  struct{}
+	unrelatedThing struct{}
+	Loger          interface{}
+)
+
+func TestResolve_Diagnostics(t *testing.T) {
+	tests := map[string]struct {
+		verbose     bool
+		register    func()
+		resolve     func() error
+		expectedErr string
+	}{
+		"suggests-pointer-counterpart": {
+			register: func() { Register(&log.Logger{}) },
+			resolve: func() error {
+				_, err := Resolve[log.Logger]()
+				return err
+			},
+			expectedErr: "depend: the dependency type 'log.Logger' was not registered; did you mean: [*log.Logger]",
+		},
+		"suggests-similar-names-closest-first": {
+			register: func() {
+				Register(&log.Logger{})
+				Register(AppMetadata{})
+				Register(appMetadataV2{})
+				Register(unrelatedThing{})
+			},
+			resolve: func() error {
+				_, err := Resolve[Loger]()
+				return err
+			},
+			expectedErr: "depend: the dependency type 'depend.Loger' was not registered; did you mean: [*log.Logger]",
+		},
+		"suggestions-are-capped": {
+			register: func() {
+				Register(AppMetadata{})
+				Register(&AppMetadata{})
+				Register([]AppMetadata{})
+				Register(appMetadataV2{})
+			},
+			resolve: func() error {
+				_, err := Resolve[*appMetadataV2]()
+				return err
+			},
+			expectedErr: "depend: the dependency type '*depend.appMetadataV2' was not registered; did you mean: [depend.appMetadataV2, *depend.AppMetadata, []depend.AppMetadata]",
+		},
+		"no-suggestions-when-nothing-is-similar": {
+			register: func() { Register(unrelatedThing{}) },
+			resolve: func() error {
+				_, err := Resolve[AppMetadata]()
+				return err
+			},
+			expectedErr: "depend: the dependency type 'depend.AppMetadata' was not registered",
+		},
+		"verbose-lists-every-registration": {
+			verbose: true,
+			register: func() {
+				Register(&log.Logger{})
+				RegisterNamed(AppMetadata{}, "primary")
+				Register(AppMetadata{})
+			},
+			resolve: func() error {
+				_, err := Resolve[unrelatedThing]()
+				return err
+			},
+			expectedErr: "depend: the dependency type 'depend.unrelatedThing' was not registered; available: [*log.Logger, depend.AppMetadata, depend.AppMetadata \"primary\"]",
+		},
+		"verbose-with-empty-container": {
+			verbose: true,
+			resolve: func() error {
+				_, err := Resolve[unrelatedThing]()
+				return err
+			},
+			expectedErr: "depend: the dependency type 'depend.unrelatedThing' was not registered",
+		},
+		"missing-name-lists-registered-names": {
+			register: func() {
+				RegisterNamed(AppMetadata{}, "primary")
+				RegisterNamed(AppMetadata{}, "replica")
+			},
+			resolve: func() error {
+				_, err := ResolveNamed[AppMetadata]("secondary")
+				return err
+			},
+			expectedErr: "depend: the dependency 'secondary' of type 'depend.AppMetadata' was not registered; registered names: [\"primary\", \"replica\"]",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ClearContainer()
+			SetVerboseErrors(tt.verbose)
+			defer SetVerboseErrors(false)
+			if tt.register != nil {
+				tt.register()
+			}
+			assertErrorMessage(t, tt.resolve(), tt.expectedErr)
+		})
+	}
+}
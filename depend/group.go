@@ -0,0 +1,140 @@
+package depend
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont/internal/reflectx"
+	"github.com/cleitonmarx/symbiont/introspection"
+)
+
+// groupPrefix is the resolve tag prefix that collects the members of a named group into a slice field.
+const groupPrefix = "group:"
+
+// groupMember is a dependency registered into a group, with the type it was registered as.
+type groupMember struct {
+	typ        reflect.Type
+	dependency any
+}
+
+// groups maps group names to their members in registration order. It is guarded by containerMu.
+var groups = make(map[string][]groupMember)
+
+// RegisterToGroup adds a dependency to a named group. Unlike ResolveAll, which collects by type,
+// a group can hold dependencies registered as different types, collected under a logical name
+// by ResolveGroup or by a slice field tagged resolve:"group:name":
+//
+//	depend.RegisterToGroup[*SearchTool]("tools", searchTool)
+//	depend.RegisterToGroup[*MailTool]("tools", mailTool)
+//
+//	type ToolManager struct {
+//		Tools []Tool `resolve:"group:tools"`
+//	}
+//
+// Group members do not occupy the unnamed or named slots of their type.
+func RegisterToGroup[T any](group string, dependency T) {
+	typeOfT := reflect.TypeFor[T]()
+	containerMu.Lock()
+	defer containerMu.Unlock()
+	groups[group] = append(groups[group], groupMember{typ: typeOfT, dependency: dependency})
+	logEvent(
+		introspection.DepRegistered,
+		reflectx.GetTypeName(typeOfT),
+		groupPrefix+group,
+		reflectx.TypeNameOf(dependency),
+		nil,
+		2,
+	)
+}
+
+// ResolveGroup retrieves every member of a group, in registration order, as values of type T.
+// Every member must be assignable to T; otherwise an error naming the first mismatching member
+// is returned, so a wrongly typed registration is caught instead of silently dropped.
+// Returns an empty non-nil slice when nothing was registered to the group.
+func ResolveGroup[T any](group string) ([]T, error) {
+	containerMu.RLock()
+	defer containerMu.RUnlock()
+
+	members, err := lookupGroup(reflect.TypeFor[[]T](), group)
+	if err != nil {
+		return nil, err
+	}
+	all := make([]T, 0, len(members))
+	for _, m := range members {
+		logEvent(
+			introspection.DepResolved,
+			reflectx.GetTypeName(m.typ),
+			groupPrefix+group,
+			reflectx.TypeNameOf(m.dependency),
+			nil,
+			2,
+		)
+		var v T
+		if m.dependency != nil {
+			v = m.dependency.(T)
+		}
+		all = append(all, v)
+	}
+	return all, nil
+}
+
+// groupName reports the group named by a resolve tag value of the form "group:name".
+func groupName(dependencyName string) (string, bool) {
+	return strings.CutPrefix(dependencyName, groupPrefix)
+}
+
+// lookupGroup returns the members of a group after checking that each is assignable to the
+// element type of sliceType. The caller must hold containerMu.
+func lookupGroup(sliceType reflect.Type, group string) ([]groupMember, error) {
+	if sliceType.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("depend: group '%s' must be resolved into a slice, got '%s'", group, reflectx.GetTypeName(sliceType))
+	}
+	elemType := sliceType.Elem()
+	members := groups[group]
+	for _, m := range members {
+		if !groupMemberAssignable(m.dependency, elemType) {
+			return nil, fmt.Errorf(
+				"depend: group '%s' member of type '%s' is not assignable to '%s'",
+				group, reflectx.TypeNameOf(m.dependency), reflectx.GetTypeName(elemType),
+			)
+		}
+	}
+	return members, nil
+}
+
+// groupMemberAssignable reports whether a group member can be stored in a slice of elemType.
+// A nil member is assignable to element types that can be nil.
+func groupMemberAssignable(dependency any, elemType reflect.Type) bool {
+	if dependency == nil {
+		switch elemType.Kind() {
+		case reflect.Interface, reflect.Pointer, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+			return true
+		}
+		return false
+	}
+	return reflect.TypeOf(dependency).AssignableTo(elemType)
+}
+
+// groupSlice builds a slice of sliceType holding the given members.
+func groupSlice(sliceType reflect.Type, members []groupMember) reflect.Value {
+	all := reflect.MakeSlice(sliceType, 0, len(members))
+	for _, m := range members {
+		v := reflect.Zero(sliceType.Elem())
+		if m.dependency != nil {
+			v = reflect.ValueOf(m.dependency)
+		}
+		all = reflect.Append(all, v)
+	}
+	return all
+}
+
+// copyGroups copies the group membership lists; the members themselves are shared.
+func copyGroups(src map[string][]groupMember) map[string][]groupMember {
+	dst := make(map[string][]groupMember, len(src))
+	for name, members := range src {
+		dst[name] = slices.Clone(members)
+	}
+	return dst
+}
@@ -0,0 +1,155 @@
+package depend
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont/introspection"
+)
+
+type LoudGreeter struct{}
+
+func (*LoudGreeter) Greet() string {
+	return "HELLO!"
+}
+
+func TestResolveGroup(t *testing.T) {
+	tests := map[string]struct {
+		register    func()
+		expected    []Greeter
+		expectedErr string
+	}{
+		"empty_non_nil_slice_when_nothing_registered": {
+			expected: []Greeter{},
+		},
+		"collects_heterogeneous_types_in_registration_order": {
+			register: func() {
+				RegisterToGroup("greeters", EnglishGreeter{})
+				RegisterToGroup[Greeter]("greeters", PortugueseGreeter{})
+				RegisterToGroup("greeters", &LoudGreeter{})
+				RegisterToGroup[Greeter]("other", EnglishGreeter{})
+			},
+			expected: []Greeter{EnglishGreeter{}, PortugueseGreeter{}, &LoudGreeter{}},
+		},
+		"member_not_assignable": {
+			register: func() {
+				RegisterToGroup("greeters", EnglishGreeter{})
+				RegisterToGroup("greeters", "not a greeter")
+			},
+			expectedErr: "depend: group 'greeters' member of type 'string' is not assignable to 'depend.Greeter'",
+		},
+		"nil_member": {
+			register: func() {
+				RegisterToGroup[Greeter]("greeters", nil)
+			},
+			expected: []Greeter{nil},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ClearContainer()
+			if tc.register != nil {
+				tc.register()
+			}
+			got, err := ResolveGroup[Greeter]("greeters")
+			assertErrorMessage(t, err, tc.expectedErr)
+			if !reflect.DeepEqual(tc.expected, got) {
+				t.Fatalf("expected %#v, got %#v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestResolveStruct_Group(t *testing.T) {
+	type (
+		plugins struct {
+			Greeters []Greeter `resolve:"group:greeters"`
+		}
+		notSlice struct {
+			Greeter Greeter `resolve:"group:greeters"`
+		}
+	)
+
+	tests := map[string]struct {
+		register    func()
+		target      any
+		expected    any
+		expectedErr string
+	}{
+		"collects_group_members": {
+			register: func() {
+				RegisterToGroup("greeters", EnglishGreeter{})
+				RegisterToGroup("greeters", &LoudGreeter{})
+				Register[Greeter](PortugueseGreeter{})
+			},
+			target:   &plugins{},
+			expected: &plugins{Greeters: []Greeter{EnglishGreeter{}, &LoudGreeter{}}},
+		},
+		"empty_non_nil_slice_when_nothing_registered": {
+			target:   &plugins{},
+			expected: &plugins{Greeters: []Greeter{}},
+		},
+		"member_not_assignable": {
+			register: func() {
+				RegisterToGroup("greeters", 42)
+			},
+			target:      &plugins{},
+			expected:    &plugins{},
+			expectedErr: "depend: group 'greeters' member of type 'int' is not assignable to 'depend.Greeter'",
+		},
+		"field_not_slice": {
+			target:      &notSlice{},
+			expected:    &notSlice{},
+			expectedErr: "depend: group 'greeters' must be resolved into a slice, got 'depend.Greeter'",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ClearContainer()
+			if tc.register != nil {
+				tc.register()
+			}
+			switch target := tc.target.(type) {
+			case *plugins:
+				resolveStructAndAssert(t, target, *tc.expected.(*plugins), tc.expectedErr)
+			case *notSlice:
+				resolveStructAndAssert(t, target, *tc.expected.(*notSlice), tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestRegisterToGroup_EventsAndSnapshot(t *testing.T) {
+	ClearContainer()
+	RegisterToGroup("greeters", EnglishGreeter{})
+	snapshot := Snapshot()
+	RegisterToGroup("greeters", PortugueseGreeter{})
+
+	if _, err := ResolveGroup[Greeter]("greeters"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	var got []string
+	for _, e := range GetEvents() {
+		got = append(got, string(e.Kind)+" "+e.Type+" "+e.Name)
+	}
+	want := []string{
+		"register depend.EnglishGreeter group:greeters",
+		"register depend.PortugueseGreeter group:greeters",
+		"resolve depend.EnglishGreeter group:greeters",
+		"resolve depend.PortugueseGreeter group:greeters",
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Fatalf("expected events %v, got %v", want, got)
+	}
+	if e := GetEvents()[0]; e.Kind != introspection.DepRegistered || e.Caller.Func != "depend.TestRegisterToGroup_EventsAndSnapshot" {
+		t.Fatalf("expected registration attributed to the test, got %+v", e)
+	}
+
+	snapshot.Restore()
+	got2, _ := ResolveGroup[Greeter]("greeters")
+	if want := []Greeter{EnglishGreeter{}}; !reflect.DeepEqual(want, got2) {
+		t.Fatalf("expected restored group %#v, got %#v", want, got2)
+	}
+}
@@ -22,8 +22,10 @@ var (
 func logEvent(action introspection.DepEventKind, depTypeName, depName, implName string, componentType reflect.Type, level int) {
 	callerFunc, file, line := reflectx.GetCallerName(level + 1)
 	caller := reflectx.FormatFunctionName(callerFunc)
+	fileName := reflectx.FormatFileName(file)
 	if strings.Contains(caller, "symbiont.(*App).") {
-		caller = ""
+		// Wired by the App on behalf of componentType; the framework location says nothing about the consumer.
+		caller, fileName, line = "", "", 0
 	}
 
 	componentName := ""
@@ -42,7 +44,7 @@ func logEvent(action introspection.DepEventKind, depTypeName, depName, implName
 		Impl: implName,
 		Caller: introspection.Caller{
 			Func: caller,
-			File: reflectx.FormatFileName(file),
+			File: fileName,
 			Line: line,
 		},
 		Component: componentName,
@@ -50,6 +52,19 @@ func logEvent(action introspection.DepEventKind, depTypeName, depName, implName
 	})
 }
 
+// lastRegistration returns the caller of the most recent registration event for a type and name.
+func lastRegistration(typeName, name string) (introspection.Caller, bool) {
+	eventMu.Lock()
+	defer eventMu.Unlock()
+	for i := len(events) - 1; i >= 0; i-- {
+		ev := events[i]
+		if ev.Kind == introspection.DepRegistered && ev.Type == typeName && ev.Name == name {
+			return ev.Caller, true
+		}
+	}
+	return introspection.Caller{}, false
+}
+
 // GetEvents returns a copy of all recorded dependency events.
 // Useful for testing and verifying dependency registration/resolution behavior.
 func GetEvents() []introspection.DepEvent {
@@ -0,0 +1,103 @@
+package depend
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/cleitonmarx/symbiont/internal/reflectx"
+	"github.com/cleitonmarx/symbiont/introspection"
+)
+
+// lazyField is implemented by Lazy so struct wiring can bind a field without knowing its type argument.
+type lazyField interface {
+	bind(name string, componentType reflect.Type) any
+}
+
+// Lazy defers the resolution of a dependency from wiring time to its first use, for dependencies
+// registered after the component is wired, such as one registered by another runnable while the
+// app runs. A Lazy field is wired by its resolve tag like any other field, but the container is only
+// read when Get is first called:
+//
+//	type Worker struct {
+//		Repo depend.Lazy[TodoRepository] `resolve:""`
+//	}
+//
+//	func (w *Worker) Run(ctx context.Context) error {
+//		repo, err := w.Repo.Get()
+//		...
+//	}
+//
+// A successful resolution is cached; a failed one is retried on the next call. Lazy values are
+// safe for concurrent use and share their cache when copied.
+type Lazy[T any] struct {
+	state *lazyState[T]
+}
+
+// lazyState holds what a Lazy resolves and its cached result.
+type lazyState[T any] struct {
+	name          string
+	componentType reflect.Type
+
+	mu       sync.Mutex
+	resolved bool
+	value    T
+}
+
+// bind returns a Lazy resolving the dependency registered under name on behalf of componentType.
+func (Lazy[T]) bind(name string, componentType reflect.Type) any {
+	return Lazy[T]{state: &lazyState[T]{name: name, componentType: componentType}}
+}
+
+// Get resolves the dependency on the first successful call and returns the cached value afterwards.
+// Returns an error if the dependency is still not registered, or if the Lazy was not wired by a
+// resolve tag.
+func (l Lazy[T]) Get() (T, error) {
+	return l.get(3)
+}
+
+// MustGet is like Get but panics if the dependency cannot be resolved.
+func (l Lazy[T]) MustGet() T {
+	dependency, err := l.get(3)
+	if err != nil {
+		panic(err.Error())
+	}
+	return dependency
+}
+
+// get resolves the dependency, attributing the resolution to the caller level frames up.
+func (l Lazy[T]) get(level int) (T, error) {
+	typeOfT := reflect.TypeFor[T]()
+	if l.state == nil {
+		return reflectx.EmptyValue[T](), fmt.Errorf("depend: Lazy[%s] was not wired by a resolve tag", reflectx.GetTypeName(typeOfT))
+	}
+	l.state.mu.Lock()
+	defer l.state.mu.Unlock()
+	if l.state.resolved {
+		return l.state.value, nil
+	}
+
+	containerMu.RLock()
+	dependency, err := lookupFieldDependency(typeOfT, l.state.name)
+	containerMu.RUnlock()
+	if err != nil {
+		return reflectx.EmptyValue[T](), err
+	}
+	logEvent(
+		introspection.DepResolved,
+		reflectx.GetTypeName(typeOfT),
+		l.state.name,
+		reflectx.TypeNameOf(dependency),
+		l.state.componentType,
+		level,
+	)
+	l.state.value, _ = dependency.(T)
+	l.state.resolved = true
+	return l.state.value, nil
+}
+
+// asLazyField reports whether fieldType is a Lazy, returning its zero value for binding.
+func asLazyField(fieldType reflect.Type) (lazyField, bool) {
+	lazy, ok := reflect.Zero(fieldType).Interface().(lazyField)
+	return lazy, ok
+}
@@ -0,0 +1,111 @@
+package depend
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont/internal/reflectx"
+	"github.com/cleitonmarx/symbiont/introspection"
+)
+
+type lazyConsumer struct {
+	Greeter Lazy[Greeter] `resolve:""`
+	Named   Lazy[Greeter] `resolve:"english"`
+}
+
+func TestLazy(t *testing.T) {
+	ClearContainer()
+	var c lazyConsumer
+	if err := ResolveStruct(&c); err != nil {
+		t.Fatalf("expected wiring to succeed before registration, got %v", err)
+	}
+	if err := reflectx.IterateStructFields(&c, CheckStructFieldValue); err != nil {
+		t.Fatalf("expected Lazy fields to pass the check before registration, got %v", err)
+	}
+	if len(GetEvents()) != 0 {
+		t.Fatalf("expected no resolve events at wiring time, got %+v", GetEvents())
+	}
+
+	_, err := c.Greeter.Get()
+	assertErrorMessage(t, err, "depend: the dependency type 'depend.Greeter' was not registered")
+
+	Register[Greeter](PortugueseGreeter{})
+	RegisterNamed[Greeter](EnglishGreeter{}, "english")
+
+	for range 2 {
+		g, err := c.Greeter.Get()
+		if err != nil || g.Greet() != "Olá!" {
+			t.Fatalf("expected the unnamed greeter, got %v, %v", g, err)
+		}
+	}
+	if g := c.Named.MustGet(); g.Greet() != "Hello!" {
+		t.Fatalf("expected the named greeter, got %v", g)
+	}
+
+	// The resolution is cached, even if the registration changes.
+	Register[Greeter](EnglishGreeter{})
+	if g := c.Greeter.MustGet(); g.Greet() != "Olá!" {
+		t.Fatalf("expected the cached greeter, got %v", g)
+	}
+
+	events := GetEvents()
+	var resolves []introspection.DepEvent
+	for _, e := range events {
+		if e.Kind == introspection.DepResolved {
+			resolves = append(resolves, e)
+		}
+	}
+	if len(resolves) != 2 {
+		t.Fatalf("expected one resolve event per Lazy, got %+v", resolves)
+	}
+	for _, e := range resolves {
+		if e.Component != "*depend.lazyConsumer" || e.Caller.Func != "depend.TestLazy" {
+			t.Fatalf("expected resolution attributed to the component and Get caller, got %+v", e)
+		}
+	}
+}
+
+func TestLazy_NotWired(t *testing.T) {
+	var l Lazy[Greeter]
+	_, err := l.Get()
+	assertErrorMessage(t, err, "depend: Lazy[depend.Greeter] was not wired by a resolve tag")
+
+	defer func() {
+		if r := recover(); r != "depend: Lazy[depend.Greeter] was not wired by a resolve tag" {
+			t.Fatalf("expected MustGet to panic with the error, got %v", r)
+		}
+	}()
+	l.MustGet()
+}
+
+func TestLazy_Concurrent(t *testing.T) {
+	ClearContainer()
+	var c lazyConsumer
+	if err := ResolveStruct(&c); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	Register[Greeter](EnglishGreeter{})
+
+	var wg sync.WaitGroup
+	for range 10 {
+		copied := c.Greeter
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := copied.Get(); err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	resolves := 0
+	for _, e := range GetEvents() {
+		if e.Kind == introspection.DepResolved {
+			resolves++
+		}
+	}
+	if resolves != 1 {
+		t.Fatalf("expected copies to share one resolution, got %d resolve events", resolves)
+	}
+}
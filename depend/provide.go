@@ -0,0 +1,92 @@
+package depend
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont/internal/reflectx"
+	"github.com/cleitonmarx/symbiont/introspection"
+)
+
+var errorType = reflect.TypeFor[error]()
+
+// Provide calls a constructor function with its parameters resolved from the container and registers the result.
+// The constructor must return a single value, or a value and an error; the value is registered, unnamed,
+// under the constructor's declared return type. Every parameter is resolved as an unnamed dependency of its type.
+//
+//	depend.Provide(func(db *sql.DB, logger *slog.Logger) TodoRepository { ... })
+//
+// An existing registration is overwritten unless strict registration is enabled, in which case a
+// duplicate is returned as an error and the constructor is not called.
+//
+// Provide complements struct tag injection and is useful when a dependency is built from other dependencies.
+func Provide(ctor any) error {
+	ctorValue := reflect.ValueOf(ctor)
+	if !ctorValue.IsValid() || ctorValue.Kind() != reflect.Func || ctorValue.IsNil() {
+		return fmt.Errorf("depend: constructor must be a function, got '%T'", ctor)
+	}
+	ctorType := ctorValue.Type()
+	ctorName, _ := reflectx.GetFunctionNameAndFileLine(ctor)
+	if ctorType.IsVariadic() {
+		return fmt.Errorf("depend: constructor '%s' must not be variadic", ctorName)
+	}
+	returnsErr := ctorType.NumOut() == 2 && ctorType.Out(1) == errorType
+	if ctorType.NumOut() != 1 && !returnsErr {
+		return fmt.Errorf("depend: constructor '%s' must return a value or a value and an error", ctorName)
+	}
+
+	typeOfT := ctorType.Out(0)
+	args := make([]reflect.Value, ctorType.NumIn())
+	containerMu.RLock()
+	if err := strictRegistrationError(typeOfT, ""); err != nil {
+		containerMu.RUnlock()
+		return err
+	}
+	for i := range args {
+		paramType := ctorType.In(i)
+		dependency, err := lookupFieldDependency(paramType, "")
+		if err != nil {
+			containerMu.RUnlock()
+			return fmt.Errorf("depend: constructor '%s': %s", ctorName, strings.TrimPrefix(err.Error(), "depend: "))
+		}
+		logEvent(
+			introspection.DepResolved,
+			reflectx.GetTypeName(paramType),
+			"",
+			reflectx.TypeNameOf(dependency),
+			nil,
+			2,
+		)
+		args[i] = reflect.ValueOf(dependency)
+		if !args[i].IsValid() {
+			// A nil interface was registered; pass the parameter's zero value instead.
+			args[i] = reflect.Zero(paramType)
+		}
+	}
+	// Release the lock before calling the constructor, which may use the container itself.
+	containerMu.RUnlock()
+
+	out := ctorValue.Call(args)
+	if returnsErr && !out[1].IsNil() {
+		return fmt.Errorf("depend: constructor '%s' failed: %w", ctorName, out[1].Interface().(error))
+	}
+
+	dependency := out[0].Interface()
+	containerMu.Lock()
+	defer containerMu.Unlock()
+	// The type may have been registered while the constructor ran.
+	if err := strictRegistrationError(typeOfT, ""); err != nil {
+		return err
+	}
+	storeDependency(typeOfT, "", dependency)
+	logEvent(
+		introspection.DepRegistered,
+		reflectx.GetTypeName(typeOfT),
+		"",
+		reflectx.TypeNameOf(dependency),
+		nil,
+		2,
+	)
+	return nil
+}
@@ -0,0 +1,142 @@
+package depend
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont/introspection"
+)
+
+// politeGreeter wraps another greeter, so it can only be built from the container.
+type politeGreeter struct{ inner Greeter }
+
+func (p politeGreeter) Greet() string { return p.inner.Greet() + " Please." }
+
+func TestProvide(t *testing.T) {
+	tests := map[string]struct {
+		ctor      any
+		wantGreet string
+		wantErr   string
+	}{
+		"resolves-parameters-and-registers-result": {
+			ctor:      func(g Greeter, suffix string) *politeGreeter { return &politeGreeter{inner: g} },
+			wantGreet: "Hello! Please.",
+		},
+		"constructor-returning-nil-error": {
+			ctor:      func(g Greeter) (*politeGreeter, error) { return &politeGreeter{inner: g}, nil },
+			wantGreet: "Hello! Please.",
+		},
+		"constructor-error": {
+			ctor:    func(Greeter) (*politeGreeter, error) { return nil, errors.New("boom") },
+			wantErr: "failed: boom",
+		},
+		"missing-parameter": {
+			ctor:    func(int) *politeGreeter { return nil },
+			wantErr: "the dependency type 'int' was not registered",
+		},
+		"not-a-function": {
+			ctor:    "ctor",
+			wantErr: "depend: constructor must be a function, got 'string'",
+		},
+		"nil-constructor": {
+			ctor:    nil,
+			wantErr: "depend: constructor must be a function, got '<nil>'",
+		},
+		"nil-function": {
+			ctor:    (func() *politeGreeter)(nil),
+			wantErr: "depend: constructor must be a function",
+		},
+		"no-return-value": {
+			ctor:    func(Greeter) {},
+			wantErr: "must return a value or a value and an error",
+		},
+		"second-result-not-error": {
+			ctor:    func() (*politeGreeter, string) { return nil, "" },
+			wantErr: "must return a value or a value and an error",
+		},
+		"variadic": {
+			ctor:    func(...Greeter) *politeGreeter { return nil },
+			wantErr: "must not be variadic",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ClearContainer()
+			Register[Greeter](EnglishGreeter{})
+			Register("suffix")
+
+			err := Provide(tt.ctor)
+			if tt.wantErr != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), "depend: ") || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				if _, err := Resolve[*politeGreeter](); err == nil {
+					t.Fatal("expected nothing to be registered")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			got, err := Resolve[*politeGreeter]()
+			if err != nil {
+				t.Fatalf("expected provided dependency, got %v", err)
+			}
+			if got.Greet() != tt.wantGreet {
+				t.Fatalf("expected %q, got %q", tt.wantGreet, got.Greet())
+			}
+		})
+	}
+}
+
+func TestProvide_NilDependency(t *testing.T) {
+	ClearContainer()
+	Register[Greeter](nil)
+
+	var got Greeter = EnglishGreeter{}
+	if err := Provide(func(g Greeter) *politeGreeter { got = g; return &politeGreeter{} }); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got != nil {
+		t.Fatalf("expected nil parameter for nil registration, got %v", got)
+	}
+}
+
+func TestProvide_StrictRegistration(t *testing.T) {
+	ClearContainer()
+	Register[Greeter](EnglishGreeter{})
+	SetStrictRegistration(true)
+	defer SetStrictRegistration(false)
+
+	called := false
+	err := Provide(func() Greeter { called = true; return PortugueseGreeter{} })
+	if err == nil || !strings.HasPrefix(err.Error(), "depend: dependency already registered for type depend.Greeter at ") {
+		t.Fatalf("expected duplicate registration error, got %v", err)
+	}
+	if called {
+		t.Fatal("expected the constructor not to be called for a duplicate registration")
+	}
+	if got, _ := Resolve[Greeter](); got.Greet() != (EnglishGreeter{}).Greet() {
+		t.Fatalf("expected the original registration to be kept, got %q", got.Greet())
+	}
+}
+
+func TestProvide_Events(t *testing.T) {
+	ClearContainer()
+	Register[Greeter](EnglishGreeter{})
+	if err := Provide(func(g Greeter) *politeGreeter { return &politeGreeter{inner: g} }); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	events := GetEvents()
+	last := events[len(events)-1]
+	if last.Kind != introspection.DepRegistered || last.Type != "*depend.politeGreeter" || last.Caller.Func != "depend.TestProvide_Events" {
+		t.Fatalf("unexpected registration event: %+v", last)
+	}
+	resolved := events[len(events)-2]
+	if resolved.Kind != introspection.DepResolved || resolved.Type != "depend.Greeter" || resolved.Caller.Func != "depend.TestProvide_Events" {
+		t.Fatalf("unexpected resolution event: %+v", resolved)
+	}
+}
@@ -0,0 +1,95 @@
+package depend
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+
+	"github.com/cleitonmarx/symbiont/internal/reflectx"
+	"github.com/cleitonmarx/symbiont/introspection"
+)
+
+// Scoped dependencies overlay the global container for the lifetime of a context,
+// typically a single request. Scoped registrations and resolutions are not recorded as
+// events, so per-request values do not grow the process-wide event log.
+
+// scopeKey is the context key under which the current scope is stored.
+type scopeKey struct{}
+
+// scope holds the dependencies registered for a context and links to its enclosing scope.
+type scope struct {
+	parent *scope
+
+	mu     sync.RWMutex
+	deps   map[reflect.Type]any
+	closed bool
+}
+
+// WithScope returns a child context carrying a new dependency scope.
+// Scopes nest: a scope created from a scoped context falls back to its parent before the global container.
+// The scope's dependencies are released when ctx is done.
+func WithScope(ctx context.Context) context.Context {
+	parent, _ := ctx.Value(scopeKey{}).(*scope)
+	s := &scope{parent: parent, deps: make(map[reflect.Type]any)}
+	context.AfterFunc(ctx, s.close)
+	return context.WithValue(ctx, scopeKey{}, s)
+}
+
+// RegisterScoped registers a dependency in the innermost scope of ctx.
+// Returns an error if ctx carries no scope or the scope has already been released.
+func RegisterScoped[T any](ctx context.Context, dependency T) error {
+	s, ok := ctx.Value(scopeKey{}).(*scope)
+	if !ok {
+		return errors.New("depend: context has no dependency scope, use WithScope")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return errors.New("depend: dependency scope is closed")
+	}
+	s.deps[reflect.TypeFor[T]()] = dependency
+	return nil
+}
+
+// ResolveCtx retrieves the dependency of type T from the scopes carried by ctx, innermost first,
+// falling back to the unnamed dependency registered in the global container.
+func ResolveCtx[T any](ctx context.Context) (T, error) {
+	typeOfT := reflect.TypeFor[T]()
+	s, _ := ctx.Value(scopeKey{}).(*scope)
+	for ; s != nil; s = s.parent {
+		if dependency, ok := s.lookup(typeOfT); ok {
+			return dependency.(T), nil
+		}
+	}
+
+	containerMu.RLock()
+	defer containerMu.RUnlock()
+	dependency, err := lookupFieldDependency(typeOfT, "")
+	if err != nil {
+		return reflectx.EmptyValue[T](), err
+	}
+	logEvent(
+		introspection.DepResolved,
+		reflectx.GetTypeName(typeOfT),
+		"",
+		reflectx.TypeNameOf(dependency),
+		nil,
+		2,
+	)
+	return dependency.(T), nil
+}
+
+func (s *scope) lookup(typeOfT reflect.Type) (any, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	dependency, ok := s.deps[typeOfT]
+	return dependency, ok
+}
+
+func (s *scope) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	clear(s.deps)
+}
@@ -0,0 +1,91 @@
+package depend
+
+import (
+	"context"
+	"testing"
+)
+
+func TestResolveCtx(t *testing.T) {
+	tests := map[string]struct {
+		setup     func(t *testing.T) context.Context
+		wantGreet string
+	}{
+		"scoped-value-shadows-global": {
+			setup: func(t *testing.T) context.Context {
+				ctx := WithScope(context.Background())
+				if err := RegisterScoped[Greeter](ctx, PortugueseGreeter{}); err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return ctx
+			},
+			wantGreet: "Olá!",
+		},
+		"falls-back-to-global": {
+			setup: func(*testing.T) context.Context {
+				return WithScope(context.Background())
+			},
+			wantGreet: "Hello!",
+		},
+		"context-without-scope": {
+			setup: func(*testing.T) context.Context {
+				return context.Background()
+			},
+			wantGreet: "Hello!",
+		},
+		"nested-scope-falls-back-to-parent": {
+			setup: func(t *testing.T) context.Context {
+				parent := WithScope(context.Background())
+				if err := RegisterScoped[Greeter](parent, PortugueseGreeter{}); err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return WithScope(parent)
+			},
+			wantGreet: "Olá!",
+		},
+		"scope-released-when-context-done": {
+			setup: func(t *testing.T) context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				scoped := WithScope(ctx)
+				if err := RegisterScoped[Greeter](scoped, PortugueseGreeter{}); err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				cancel()
+				// The scope is released asynchronously; wait until it rejects registrations.
+				for RegisterScoped[Greeter](scoped, PortugueseGreeter{}) == nil {
+				}
+				return context.WithoutCancel(scoped)
+			},
+			wantGreet: "Hello!",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ClearContainer()
+			Register[Greeter](EnglishGreeter{})
+
+			got, err := ResolveCtx[Greeter](tt.setup(t))
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got.Greet() != tt.wantGreet {
+				t.Fatalf("expected %q, got %q", tt.wantGreet, got.Greet())
+			}
+		})
+	}
+}
+
+func TestResolveCtx_NotRegistered(t *testing.T) {
+	ClearContainer()
+	_, err := ResolveCtx[Greeter](WithScope(context.Background()))
+	if err == nil || err.Error() != "depend: the dependency type 'depend.Greeter' was not registered" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestRegisterScoped_WithoutScope(t *testing.T) {
+	err := RegisterScoped[Greeter](context.Background(), EnglishGreeter{})
+	if err == nil || err.Error() != "depend: context has no dependency scope, use WithScope" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,67 @@
+package depend
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+
+	"github.com/cleitonmarx/symbiont/introspection"
+)
+
+// Restorer holds a copy of the container taken by Snapshot.
+type Restorer struct {
+	container         map[reflect.Type]map[string]any
+	registrationOrder map[reflect.Type][]string
+	groups            map[string][]groupMember
+	events            []introspection.DepEvent
+}
+
+// Snapshot captures the current registrations and event log so they can be restored later.
+// The registration table is copied; the registered values themselves are shared.
+// Typically used in tests to register temporary mocks:
+//
+//	t.Cleanup(depend.Snapshot().Restore)
+//	depend.Register[Mailer](&fakeMailer{})
+func Snapshot() Restorer {
+	containerMu.RLock()
+	eventMu.Lock()
+	defer containerMu.RUnlock()
+	defer eventMu.Unlock()
+
+	return Restorer{
+		container:         copyContainer(container),
+		registrationOrder: copyRegistrationOrder(registrationOrder),
+		groups:            copyGroups(groups),
+		events:            slices.Clone(events),
+	}
+}
+
+// Restore replaces the container's registrations and event log with the snapshot,
+// discarding everything registered since Snapshot. A Restorer can be restored more than once.
+func (r Restorer) Restore() {
+	containerMu.Lock()
+	eventMu.Lock()
+	defer containerMu.Unlock()
+	defer eventMu.Unlock()
+
+	container = copyContainer(r.container)
+	registrationOrder = copyRegistrationOrder(r.registrationOrder)
+	groups = copyGroups(r.groups)
+	events = slices.Clone(r.events)
+}
+
+func copyContainer(src map[reflect.Type]map[string]any) map[reflect.Type]map[string]any {
+	dst := make(map[reflect.Type]map[string]any, len(src))
+	for t, byName := range src {
+		dst[t] = maps.Clone(byName)
+	}
+	return dst
+}
+
+func copyRegistrationOrder(src map[reflect.Type][]string) map[reflect.Type][]string {
+	dst := make(map[reflect.Type][]string, len(src))
+	for t, names := range src {
+		dst[t] = slices.Clone(names)
+	}
+	return dst
+}
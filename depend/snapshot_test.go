@@ -0,0 +1,59 @@
+package depend
+
+import (
+	"testing"
+)
+
+func TestSnapshot_Restore(t *testing.T) {
+	ClearContainer()
+	Register[Greeter](EnglishGreeter{})
+	RegisterNamed[Greeter](EnglishGreeter{}, "formal")
+	eventsBefore := len(GetEvents())
+
+	restorer := Snapshot()
+	Register[Greeter](PortugueseGreeter{})
+	RegisterNamed[Greeter](PortugueseGreeter{}, "formal")
+	RegisterNamed[Greeter](PortugueseGreeter{}, "casual")
+	Register("temporary")
+
+	if got, _ := Resolve[Greeter](); got.Greet() != "Olá!" {
+		t.Fatalf("expected override before restore, got %q", got.Greet())
+	}
+
+	restorer.Restore()
+
+	if got, _ := Resolve[Greeter](); got.Greet() != "Hello!" {
+		t.Fatalf("expected original unnamed greeter, got %q", got.Greet())
+	}
+	if got, _ := ResolveNamed[Greeter]("formal"); got.Greet() != "Hello!" {
+		t.Fatalf("expected original named greeter, got %q", got.Greet())
+	}
+	if _, err := ResolveNamed[Greeter]("casual"); err == nil {
+		t.Fatal("expected registration made after the snapshot to be gone")
+	}
+	if _, err := Resolve[string](); err == nil {
+		t.Fatal("expected type registered after the snapshot to be gone")
+	}
+	if got := ResolveAll[Greeter](); len(got) != 2 {
+		t.Fatalf("expected 2 greeters, got %d", len(got))
+	}
+	// The resolutions above were recorded after Restore.
+	if got := len(GetEvents()); got != eventsBefore+4 {
+		t.Fatalf("expected %d events, got %d", eventsBefore+4, got)
+	}
+}
+
+func TestSnapshot_IsIsolatedFromLaterRegistrations(t *testing.T) {
+	ClearContainer()
+	Register[Greeter](EnglishGreeter{})
+	restorer := Snapshot()
+
+	RegisterNamed[Greeter](PortugueseGreeter{}, "pt")
+	restorer.Restore()
+	RegisterNamed[Greeter](PortugueseGreeter{}, "pt")
+	restorer.Restore()
+
+	if _, err := ResolveNamed[Greeter]("pt"); err == nil {
+		t.Fatal("expected a restored snapshot to be reusable")
+	}
+}
@@ -1,17 +1,39 @@
 package symbiont
 
 import (
+	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/cleitonmarx/symbiont/internal/reflectx"
 )
 
+// Phase identifies the lifecycle phase in which an error occurred.
+type Phase string
+
+const (
+	// PhaseUnknown is the zero Phase, used when an error is not tied to a lifecycle phase.
+	PhaseUnknown Phase = ""
+	// PhaseInit marks failures returned or raised by an initializer's Initialize method.
+	PhaseInit Phase = "init"
+	// PhaseWiring marks failures injecting dependencies or configuration into struct fields.
+	PhaseWiring Phase = "wiring"
+	// PhaseIntrospect marks failures returned or raised by an introspector.
+	PhaseIntrospect Phase = "introspect"
+	// PhaseRun marks failures returned or raised by a runnable, including readiness failures.
+	PhaseRun Phase = "run"
+	// PhaseShutdown marks failures while shutting down components.
+	PhaseShutdown Phase = "shutdown"
+)
+
 // Error represents a symbiont error with context about the component that failed.
 // It includes the original error, component name or function name, and source location for debugging.
+// Phase reports the lifecycle phase of the failure; it is not part of the error message.
 type Error struct {
 	Err           error
 	ComponentName string
+	Phase         Phase
 }
 
 // NewError wraps an error with component context (type name or function name and location).
@@ -25,7 +47,44 @@ func NewError(err error, component any) Error {
 	}
 }
 
+// newPhaseError wraps an error with component context like NewError and records the lifecycle phase.
+func newPhaseError(err error, component any, phase Phase) Error {
+	e := NewError(err, component)
+	e.Phase = phase
+	return e
+}
+
 // Error implements the error interface, returning a formatted error message with component context.
 func (e Error) Error() string {
 	return fmt.Sprintf("error: %v, component: %s", e.Err, e.ComponentName)
 }
+
+// Unwrap returns the wrapped error, so errors.As and errors.Is see through the component context.
+func (e Error) Unwrap() error {
+	return e.Err
+}
+
+// InitTimeoutError reports that an initializer did not complete within the timeout set by WithInitTimeout.
+// Run returns it wrapped in an Error with PhaseInit, which names the component in the message.
+type InitTimeoutError struct {
+	ComponentName string
+	Timeout       time.Duration
+}
+
+// NewInitTimeoutError creates an InitTimeoutError naming the initializer's type.
+func NewInitTimeoutError(component any, timeout time.Duration) InitTimeoutError {
+	return InitTimeoutError{
+		ComponentName: reflectx.GetTypeName(reflect.TypeOf(component)),
+		Timeout:       timeout,
+	}
+}
+
+// Error implements the error interface, reporting the exceeded timeout.
+func (e InitTimeoutError) Error() string {
+	return fmt.Sprintf("initializer timed out after %s", e.Timeout)
+}
+
+// Unwrap returns context.DeadlineExceeded so callers can match the timeout with errors.Is.
+func (e InitTimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
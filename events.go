@@ -0,0 +1,115 @@
+package symbiont
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/cleitonmarx/symbiont/depend"
+	"github.com/cleitonmarx/symbiont/introspection"
+)
+
+// Lifecycle event kinds written by WithEventWriter.
+// Dependency events keep the kinds of introspection.DepEventKind.
+const (
+	eventInitializerStarted  = "initializer_started"
+	eventInitializerFinished = "initializer_finished"
+	eventInitializerFailed   = "initializer_failed"
+	eventRunnableStarted     = "runnable_started"
+	eventRunnableStopped     = "runnable_stopped"
+	eventRunnableFailed      = "runnable_failed"
+	eventShutdownStarted     = "shutdown_started"
+	eventShutdownCompleted   = "shutdown_completed"
+)
+
+// lifecycleEvent is a single JSON line written to the event writer.
+type lifecycleEvent struct {
+	Kind       string                  `json:"kind"`
+	Time       time.Time               `json:"time"`
+	Component  string                  `json:"component,omitempty"`
+	DurationMs float64                 `json:"durationMs,omitempty"`
+	Error      string                  `json:"error,omitempty"`
+	Reason     string                  `json:"reason,omitempty"` // why a runnable returned, set on runnable stop and failure events
+	Dep        *introspection.DepEvent `json:"dep,omitempty"`
+}
+
+// eventStream writes lifecycle and dependency events as JSON Lines.
+// A nil *eventStream discards all events.
+type eventStream struct {
+	mu      sync.Mutex
+	enc     *json.Encoder
+	depSeen int
+}
+
+// WithEventWriter streams lifecycle events to w as JSON Lines (fluent method).
+// Initializer, runnable, and shutdown events are written as they happen; dependency registrations
+// and resolutions recorded by the depend package are flushed, in order, before each lifecycle event.
+// Writes are serialized, and write errors are ignored. A nil writer disables the stream.
+func (a *App) WithEventWriter(w io.Writer) *App {
+	if w == nil {
+		a.events = nil
+		return a
+	}
+	a.events = &eventStream{enc: json.NewEncoder(w)}
+	return a
+}
+
+// emit flushes pending dependency events and writes a lifecycle event.
+func (s *eventStream) emit(kind string, component any, duration time.Duration, err error) {
+	if s == nil {
+		return
+	}
+	s.write(newLifecycleEvent(kind, component, duration, err))
+}
+
+// emitExit writes a runnable stop or failure event carrying the runnable's exit reason.
+func (s *eventStream) emitExit(kind string, component any, exit introspection.RunnableExitEvent, err error) {
+	if s == nil {
+		return
+	}
+	ev := newLifecycleEvent(kind, component, exit.Duration, err)
+	ev.Reason = string(exit.Reason)
+	s.write(ev)
+}
+
+// newLifecycleEvent builds a lifecycle event stamped with the current time.
+func newLifecycleEvent(kind string, component any, duration time.Duration, err error) lifecycleEvent {
+	ev := lifecycleEvent{
+		Kind:       kind,
+		Time:       time.Now(),
+		DurationMs: float64(duration) / float64(time.Millisecond),
+	}
+	if component != nil {
+		ev.Component = componentName(component)
+	}
+	if err != nil {
+		ev.Error = err.Error()
+	}
+	return ev
+}
+
+// write flushes pending dependency events and encodes ev.
+func (s *eventStream) write(ev lifecycleEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.flushDeps(ev.Time)
+	_ = s.enc.Encode(ev)
+}
+
+// flushDeps writes dependency events recorded since the last flush. The caller must hold s.mu.
+func (s *eventStream) flushDeps(now time.Time) {
+	deps := depend.GetEvents()
+	if len(deps) < s.depSeen {
+		// The container was cleared; start over.
+		s.depSeen = 0
+	}
+	for i := s.depSeen; i < len(deps); i++ {
+		_ = s.enc.Encode(lifecycleEvent{
+			Kind: string(deps[i].Kind),
+			Time: now,
+			Dep:  &deps[i],
+		})
+	}
+	s.depSeen = len(deps)
+}
@@ -0,0 +1,106 @@
+package symbiont
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont/depend"
+	"github.com/cleitonmarx/symbiont/introspection"
+)
+
+// failingRunnable returns an error as soon as it runs.
+type failingRunnable struct{}
+
+func (f *failingRunnable) Run(context.Context) error { return errors.New("boom") }
+
+func TestApp_WithEventWriter(t *testing.T) {
+	tests := map[string]struct {
+		build     func() *App
+		wantKinds []string
+		wantErr   bool
+	}{
+		"successful-run": {
+			build: func() *App {
+				return NewApp().
+					Initialize(&depRegisterInitializer{value: "hello"}).
+					Host(&resolveDepRun{})
+			},
+			wantKinds: []string{
+				eventInitializerStarted,
+				string(introspection.DepRegistered),
+				eventInitializerFinished,
+				string(introspection.DepResolved),
+				eventRunnableStarted,
+				eventRunnableStopped,
+				eventShutdownStarted,
+				eventShutdownCompleted,
+			},
+		},
+		"failing-initializer": {
+			build: func() *App {
+				return NewApp().Initialize(&errInitializer{})
+			},
+			wantKinds: []string{
+				eventInitializerStarted,
+				eventInitializerFailed,
+				eventShutdownStarted,
+				eventShutdownCompleted,
+			},
+			wantErr: true,
+		},
+		"failing-runnable": {
+			build: func() *App {
+				return NewApp().Host(&failingRunnable{})
+			},
+			wantKinds: []string{
+				eventRunnableStarted,
+				eventRunnableFailed,
+				eventShutdownStarted,
+				eventShutdownCompleted,
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			depend.ClearContainer()
+			var buf bytes.Buffer
+			err := tt.build().WithEventWriter(&buf).RunWithContext(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+
+			var kinds []string
+			scanner := bufio.NewScanner(&buf)
+			for scanner.Scan() {
+				var ev lifecycleEvent
+				if err := json.Unmarshal(scanner.Bytes(), &ev); err != nil {
+					t.Fatalf("invalid JSON line %q: %v", scanner.Text(), err)
+				}
+				if ev.Time.IsZero() {
+					t.Fatalf("event %q has no time", ev.Kind)
+				}
+				if ev.Kind == eventInitializerFailed || ev.Kind == eventRunnableFailed {
+					if ev.Error == "" || ev.Component == "" {
+						t.Fatalf("failure event missing error or component: %+v", ev)
+					}
+				}
+				if ev.Kind == eventRunnableStopped || ev.Kind == eventRunnableFailed {
+					if ev.Reason == "" {
+						t.Fatalf("runnable exit event missing reason: %+v", ev)
+					}
+				}
+				kinds = append(kinds, ev.Kind)
+			}
+			if !slices.Equal(kinds, tt.wantKinds) {
+				t.Fatalf("expected kinds %v, got %v", tt.wantKinds, kinds)
+			}
+		})
+	}
+}
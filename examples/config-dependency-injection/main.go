@@ -16,8 +16,6 @@ type AppMetadata struct {
 	Environment string
 }
 
-type startupIDContextKey struct{}
-
 // AppMetadataInitializer loads configuration, registers dependencies, and enriches context.
 type AppMetadataInitializer struct {
 	ServiceName string `config:"SERVICE_NAME" default:"todo-api"`
@@ -31,7 +29,7 @@ func (i AppMetadataInitializer) Initialize(ctx context.Context) (context.Context
 	depend.Register(AppMetadata(i))
 
 	startupID := time.Now().UTC().Format(time.RFC3339Nano)
-	ctx = context.WithValue(ctx, startupIDContextKey{}, startupID)
+	ctx = context.WithValue(ctx, symbiont.ContextKey("startup_id"), startupID)
 
 	return ctx, nil
 }
@@ -41,11 +39,12 @@ type ConfiguredWorker struct {
 	Logger       *log.Logger   `resolve:""`
 	Metadata     AppMetadata   `resolve:""`
 	PollInterval time.Duration `config:"POLL_INTERVAL" default:"2s"`
+	StartupID    string        `context:"startup_id"`
 }
 
-// Run starts the worker, reads startup ID from context, and logs injected values until shutdown.
+// Run starts the worker and logs injected values until shutdown.
 func (w ConfiguredWorker) Run(ctx context.Context) error {
-	startupID, _ := ctx.Value(startupIDContextKey{}).(string)
+	startupID := w.StartupID
 	if startupID == "" {
 		startupID = "unknown"
 	}
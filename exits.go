@@ -0,0 +1,68 @@
+package symbiont
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cleitonmarx/symbiont/introspection"
+)
+
+// maxRecordedExits bounds the runnable exits kept for introspection, so isolated runnables
+// restarting for a long time do not grow the log without limit.
+const maxRecordedExits = 1000
+
+// runnableExitLog records runnable exits in the order they happen, keeping the most recent ones.
+type runnableExitLog struct {
+	mu    sync.Mutex
+	exits []introspection.RunnableExitEvent
+}
+
+// record appends an exit, dropping the oldest one once the log is full.
+func (l *runnableExitLog) record(exit introspection.RunnableExitEvent) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.exits) == maxRecordedExits {
+		l.exits = slices.Delete(l.exits, 0, 1)
+	}
+	l.exits = append(l.exits, exit)
+}
+
+// events returns a copy of the recorded exits, oldest first.
+func (l *runnableExitLog) events() []introspection.RunnableExitEvent {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return slices.Clone(l.exits)
+}
+
+// runnableExit describes a return from a runnable's Run method after running for d.
+func runnableExit(ctx context.Context, runnable any, d time.Duration, err error) introspection.RunnableExitEvent {
+	exit := introspection.RunnableExitEvent{
+		Type:     componentName(runnable),
+		Duration: d,
+		Reason:   exitReason(ctx, err),
+	}
+	if n, ok := runnable.(Named); ok {
+		exit.Name = n.Name()
+	}
+	if err != nil {
+		exit.Error = err.Error()
+	}
+	return exit
+}
+
+// exitReason classifies why Run returned. Returning after ctx is done counts as a cancellation,
+// as long as the error, if any, is the context's own error.
+func exitReason(ctx context.Context, err error) introspection.RunnableExitReason {
+	ctxErr := ctx.Err()
+	switch {
+	case err == nil && ctxErr == nil:
+		return introspection.ExitReturned
+	case err == nil, errors.Is(err, ctxErr):
+		return introspection.ExitCanceled
+	default:
+		return introspection.ExitError
+	}
+}
@@ -0,0 +1,137 @@
+package symbiont
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont/depend"
+	"github.com/cleitonmarx/symbiont/introspection"
+)
+
+// ctxErrRunnable returns its context's error once the context is done.
+type ctxErrRunnable struct{}
+
+func (ctxErrRunnable) Run(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestRunSafe_Exit(t *testing.T) {
+	tests := map[string]struct {
+		runnable   Runnable
+		canceled   bool
+		wantType   string
+		wantName   string
+		wantReason introspection.RunnableExitReason
+		wantErr    bool
+	}{
+		"normal-return": {
+			runnable:   &namedRunnable{name: "api"},
+			wantType:   "*symbiont.namedRunnable",
+			wantName:   "api",
+			wantReason: introspection.ExitReturned,
+		},
+		"return-after-cancel": {
+			runnable:   &waitRunnable{done: make(chan struct{})},
+			canceled:   true,
+			wantType:   "*symbiont.waitRunnable",
+			wantReason: introspection.ExitCanceled,
+		},
+		"context-error": {
+			runnable:   ctxErrRunnable{},
+			canceled:   true,
+			wantType:   "symbiont.ctxErrRunnable",
+			wantReason: introspection.ExitCanceled,
+			wantErr:    true,
+		},
+		"error": {
+			runnable:   &runCloser{willErr: true},
+			wantType:   "*symbiont.runCloser",
+			wantReason: introspection.ExitError,
+			wantErr:    true,
+		},
+		"error-after-cancel": {
+			runnable:   &runCloser{willErr: true},
+			canceled:   true,
+			wantType:   "*symbiont.runCloser",
+			wantReason: introspection.ExitError,
+			wantErr:    true,
+		},
+		"panic": {
+			runnable:   &runCloser{willPanic: true},
+			wantType:   "*symbiont.runCloser",
+			wantReason: introspection.ExitError,
+			wantErr:    true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.canceled {
+				cancel()
+			}
+
+			exit, err := runSafe(ctx, runnableSpecs{original: tt.runnable, executor: tt.runnable})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if exit.Type != tt.wantType || exit.Name != tt.wantName || exit.Reason != tt.wantReason {
+				t.Fatalf("expected exit {%s %s %s}, got %+v", tt.wantType, tt.wantName, tt.wantReason, exit)
+			}
+			if tt.wantErr == (exit.Error == "") {
+				t.Fatalf("expected exit error to be set %v, got %q", tt.wantErr, exit.Error)
+			}
+			if exit.Duration < 0 {
+				t.Fatalf("expected a non-negative duration, got %v", exit.Duration)
+			}
+		})
+	}
+}
+
+func TestRunnableExitLog_KeepsMostRecent(t *testing.T) {
+	var l runnableExitLog
+	for i := 0; i <= maxRecordedExits; i++ {
+		l.record(introspection.RunnableExitEvent{Type: strconv.Itoa(i)})
+	}
+
+	exits := l.events()
+	if len(exits) != maxRecordedExits {
+		t.Fatalf("expected %d exits, got %d", maxRecordedExits, len(exits))
+	}
+	if exits[0].Type != "1" || exits[len(exits)-1].Type != strconv.Itoa(maxRecordedExits) {
+		t.Fatalf("expected the oldest exit to be dropped, got first %q and last %q", exits[0].Type, exits[len(exits)-1].Type)
+	}
+}
+
+func TestApp_IntrospectionSnapshot_RunnableExits(t *testing.T) {
+	depend.ClearContainer()
+	defer depend.ClearContainer()
+
+	app := NewApp().Host(&runCloser{willErr: true, log: &[]string{}}, &waitRunnable{done: make(chan struct{})})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := app.RunWithContext(ctx); err == nil {
+		t.Fatal("expected the failing runnable to stop the app")
+	}
+
+	reasons := make(map[string]introspection.RunnableExitReason)
+	for _, exit := range app.IntrospectionSnapshot().RunnableExits {
+		reasons[exit.Type] = exit.Reason
+	}
+	want := map[string]introspection.RunnableExitReason{
+		"*symbiont.runCloser":    introspection.ExitError,
+		"*symbiont.waitRunnable": introspection.ExitCanceled,
+	}
+	if len(reasons) != len(want) {
+		t.Fatalf("expected exits %v, got %v", want, reasons)
+	}
+	for typ, reason := range want {
+		if reasons[typ] != reason {
+			t.Fatalf("expected %s to exit with %q, got %q", typ, reason, reasons[typ])
+		}
+	}
+}
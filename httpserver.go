@@ -0,0 +1,120 @@
+package symbiont
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+)
+
+// HTTPServer is a Runnable that hosts an *http.Server, replacing the listen, serve and shutdown
+// boilerplate every HTTP runnable would otherwise repeat. It implements ReadyChecker and
+// ContextCloser: it is ready once its address accepts TCP connections, and it shuts down
+// gracefully within the app's shutdown timeout.
+type HTTPServer struct {
+	srv *http.Server
+
+	mu         sync.Mutex
+	listenAddr string
+	running    bool
+}
+
+// HTTPRunnable creates an HTTPServer serving srv on srv.Addr once hosted.
+// As with http.Server, an empty address listens on ":http".
+//
+//	app := symbiont.NewApp().
+//		Host(symbiont.HTTPRunnable(&http.Server{Addr: ":8080", Handler: mux}))
+func HTTPRunnable(srv *http.Server) *HTTPServer {
+	return &HTTPServer{srv: srv}
+}
+
+// Run listens on the server's address and serves requests until the context is canceled,
+// then shuts the server down with a context bounded by the app's shutdown timeout.
+func (h *HTTPServer) Run(ctx context.Context) error {
+	h.mu.Lock()
+	if h.running {
+		h.mu.Unlock()
+		return errors.New("symbiont: http server is already running")
+	}
+	h.running = true
+	h.mu.Unlock()
+	// Allow the server to be run again once this run returns, such as when an isolated runnable restarts,
+	// and stop reporting the address once the server no longer listens on it.
+	defer func() {
+		h.mu.Lock()
+		h.running = false
+		h.listenAddr = ""
+		h.mu.Unlock()
+	}()
+
+	addr := h.srv.Addr
+	if addr == "" {
+		addr = ":http"
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("symbiont: http server: %w", err)
+	}
+
+	h.mu.Lock()
+	h.listenAddr = listener.Addr().String()
+	h.mu.Unlock()
+
+	if err := serveUntilDone(ctx, h.srv, listener); err != nil {
+		return fmt.Errorf("symbiont: http server: %w", err)
+	}
+	return nil
+}
+
+// IsReady reports whether the server accepts TCP connections on its listening address.
+func (h *HTTPServer) IsReady(ctx context.Context) error {
+	addr := h.Addr()
+	if addr == "" {
+		return errors.New("symbiont: http server is not listening")
+	}
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("symbiont: http server is not ready: %w", err)
+	}
+	return conn.Close()
+}
+
+// Close shuts the server down gracefully within ctx. It is called by the app during shutdown
+// and is a no-op once Run has already shut the server down.
+func (h *HTTPServer) Close(ctx context.Context) error {
+	if err := h.srv.Shutdown(ctx); err != nil {
+		return fmt.Errorf("symbiont: http server: %w", err)
+	}
+	return nil
+}
+
+// Addr returns the address the server is listening on, or an empty string when it is not running.
+func (h *HTTPServer) Addr() string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.listenAddr
+}
+
+// serveUntilDone serves srv on listener until ctx is canceled, then shuts srv down with a
+// context obtained from ShutdownContext. A server closed by another caller is not an error.
+func serveUntilDone(ctx context.Context, srv *http.Server, listener net.Listener) error {
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.Serve(listener)
+	}()
+
+	select {
+	case err := <-errCh:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	case <-ctx.Done():
+		shutdownCtx, cancel := ShutdownContext(ctx)
+		defer cancel()
+		return srv.Shutdown(shutdownCtx)
+	}
+}
@@ -0,0 +1,115 @@
+package symbiont
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont/config"
+	"github.com/cleitonmarx/symbiont/depend"
+)
+
+func TestHTTPRunnable(t *testing.T) {
+	depend.ClearContainer()
+	config.ResetGlobalProvider()
+	defer func() { depend.ClearContainer(); config.ResetGlobalProvider() }()
+
+	release := make(chan struct{})
+	mux := http.NewServeMux()
+	mux.HandleFunc("/hello", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, "hello")
+	})
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, _ *http.Request) {
+		<-release
+		_, _ = io.WriteString(w, "done")
+	})
+	server := HTTPRunnable(&http.Server{Addr: "127.0.0.1:0", Handler: mux, ReadHeaderTimeout: time.Second})
+	if err := server.IsReady(context.Background()); err == nil {
+		t.Fatal("expected server not to be ready before Run")
+	}
+
+	app := NewApp().Host(server)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errCh := app.RunAsync(ctx)
+	if err := app.WaitForReadiness(ctx, time.Second); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	resp, err := http.Get("http://" + server.Addr() + "/hello")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if string(body) != "hello" {
+		t.Fatalf("expected body %q, got %q", "hello", body)
+	}
+
+	if err := server.Run(ctx); err == nil || err.Error() != "symbiont: http server is already running" {
+		t.Fatalf("expected already running error, got %v", err)
+	}
+
+	// An in-flight request completes during graceful shutdown.
+	slowCh := make(chan string, 1)
+	go func() {
+		resp, err := http.Get("http://" + server.Addr() + "/slow")
+		if err != nil {
+			slowCh <- err.Error()
+			return
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		slowCh <- string(body)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+
+	if got := <-slowCh; got != "done" {
+		t.Fatalf("expected in-flight request to complete, got %q", got)
+	}
+	select {
+	case err := <-errCh:
+		if err != nil {
+			t.Fatalf("expected clean shutdown, got %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected app to shut down")
+	}
+	if err := server.IsReady(context.Background()); err == nil || err.Error() != "symbiont: http server is not listening" {
+		t.Fatalf("expected server not to be listening after shutdown, got %v", err)
+	}
+	if addr := server.Addr(); addr != "" {
+		t.Fatalf("expected no address after shutdown, got %q", addr)
+	}
+}
+
+func TestHTTPRunnable_ListenError(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	defer listener.Close()
+
+	server := HTTPRunnable(&http.Server{Addr: listener.Addr().String(), ReadHeaderTimeout: time.Second})
+	err = server.Run(context.Background())
+	if err == nil || !strings.HasPrefix(err.Error(), "symbiont: http server: listen tcp") {
+		t.Fatalf("expected listen error, got %v", err)
+	}
+}
+
+func TestHTTPRunnable_CloseBeforeRun(t *testing.T) {
+	server := HTTPRunnable(&http.Server{Addr: "127.0.0.1:0", ReadHeaderTimeout: time.Second})
+	if err := server.Close(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := server.Run(context.Background()); err != nil {
+		t.Fatalf("expected a closed server to stop without error, got %v", err)
+	}
+}
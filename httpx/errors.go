@@ -0,0 +1,82 @@
+package httpx
+
+import (
+	"errors"
+	"net/http"
+)
+
+// Sentinel errors mapped to HTTP status codes by NewErrorMapper.
+// Wrap them to add detail, e.g. fmt.Errorf("%w: title is required", httpx.ErrValidation).
+var (
+	ErrValidation   = errors.New("validation failed")
+	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
+	ErrUnauthorized = errors.New("unauthorized")
+)
+
+// errorMapping maps errors matched by match to an HTTP status code.
+type errorMapping struct {
+	match  func(error) bool
+	status int
+}
+
+// ErrorMapper maps errors to HTTP status codes and writes them as JSON error responses.
+// Mappings are not safe to add while the mapper is in use; configure it at startup.
+type ErrorMapper struct {
+	custom   []errorMapping
+	defaults []errorMapping
+}
+
+// NewErrorMapper creates a mapper for the package sentinel errors:
+// ErrValidation (400), ErrUnauthorized (401), ErrNotFound (404), and ErrConflict (409).
+func NewErrorMapper() *ErrorMapper {
+	return &ErrorMapper{
+		defaults: []errorMapping{
+			{match: isMatcher(ErrValidation), status: http.StatusBadRequest},
+			{match: isMatcher(ErrUnauthorized), status: http.StatusUnauthorized},
+			{match: isMatcher(ErrNotFound), status: http.StatusNotFound},
+			{match: isMatcher(ErrConflict), status: http.StatusConflict},
+		},
+	}
+}
+
+// Map maps errors matching target with errors.Is to status (fluent method).
+// Custom mappings are checked before the defaults, in the order they were added.
+func (m *ErrorMapper) Map(target error, status int) *ErrorMapper {
+	return m.MapFunc(isMatcher(target), status)
+}
+
+// MapFunc maps errors for which match returns true to status (fluent method),
+// which covers typed errors matched with errors.As.
+func (m *ErrorMapper) MapFunc(match func(error) bool, status int) *ErrorMapper {
+	m.custom = append(m.custom, errorMapping{match: match, status: status})
+	return m
+}
+
+// Status returns the status code mapped to err, or 500 if no mapping matches.
+func (m *ErrorMapper) Status(err error) int {
+	for _, mappings := range [][]errorMapping{m.custom, m.defaults} {
+		for _, mapping := range mappings {
+			if mapping.match(err) {
+				return mapping.status
+			}
+		}
+	}
+	return http.StatusInternalServerError
+}
+
+// Respond writes err as a JSON error response with its mapped status code.
+// Mapped errors expose their message; unmapped errors are reported as a generic
+// "Internal Server Error" so internal details do not leak to clients.
+func (m *ErrorMapper) Respond(w http.ResponseWriter, err error) error {
+	status := m.Status(err)
+	message := err.Error()
+	if status == http.StatusInternalServerError {
+		message = http.StatusText(status)
+	}
+	return RespondError(w, status, message)
+}
+
+func isMatcher(target error) func(error) bool {
+	return func(err error) bool { return errors.Is(err, target) }
+}
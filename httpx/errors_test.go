@@ -0,0 +1,88 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// quotaError is a typed error mapped with MapFunc.
+type quotaError struct{ limit int }
+
+func (e quotaError) Error() string { return fmt.Sprintf("quota of %d exceeded", e.limit) }
+
+func TestErrorMapper(t *testing.T) {
+	errCustomNotFound := fmt.Errorf("%w: archived", ErrNotFound)
+	mapper := NewErrorMapper().
+		Map(context.DeadlineExceeded, http.StatusGatewayTimeout).
+		Map(errCustomNotFound, http.StatusGone).
+		MapFunc(func(err error) bool {
+			var qe quotaError
+			return errors.As(err, &qe)
+		}, http.StatusTooManyRequests)
+
+	tests := map[string]struct {
+		err        error
+		wantStatus int
+		wantBody   string
+	}{
+		"validation": {
+			err:        fmt.Errorf("%w: title is required", ErrValidation),
+			wantStatus: http.StatusBadRequest,
+			wantBody:   "{\"error\":\"validation failed: title is required\"}\n",
+		},
+		"unauthorized": {
+			err:        ErrUnauthorized,
+			wantStatus: http.StatusUnauthorized,
+			wantBody:   "{\"error\":\"unauthorized\"}\n",
+		},
+		"not-found": {
+			err:        fmt.Errorf("todo 7: %w", ErrNotFound),
+			wantStatus: http.StatusNotFound,
+			wantBody:   "{\"error\":\"todo 7: not found\"}\n",
+		},
+		"conflict": {
+			err:        ErrConflict,
+			wantStatus: http.StatusConflict,
+			wantBody:   "{\"error\":\"conflict\"}\n",
+		},
+		"custom-mapping-takes-precedence": {
+			err:        errCustomNotFound,
+			wantStatus: http.StatusGone,
+			wantBody:   "{\"error\":\"not found: archived\"}\n",
+		},
+		"custom-sentinel": {
+			err:        fmt.Errorf("calling mailer: %w", context.DeadlineExceeded),
+			wantStatus: http.StatusGatewayTimeout,
+			wantBody:   "{\"error\":\"calling mailer: context deadline exceeded\"}\n",
+		},
+		"typed-error": {
+			err:        fmt.Errorf("send: %w", quotaError{limit: 5}),
+			wantStatus: http.StatusTooManyRequests,
+			wantBody:   "{\"error\":\"send: quota of 5 exceeded\"}\n",
+		},
+		"unmapped-error-hides-details": {
+			err:        errors.New("pq: connection refused"),
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   "{\"error\":\"Internal Server Error\"}\n",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := mapper.Status(tt.err); got != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, got)
+			}
+			rec := httptest.NewRecorder()
+			if err := mapper.Respond(rec, tt.err); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if rec.Code != tt.wantStatus || rec.Body.String() != tt.wantBody {
+				t.Fatalf("expected %d %q, got %d %q", tt.wantStatus, tt.wantBody, rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
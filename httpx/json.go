@@ -0,0 +1,61 @@
+package httpx
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+)
+
+// Encoder writes v to w in JSON form. It matches the shape of json.NewEncoder(w).Encode,
+// so faster JSON libraries can be plugged in with a small adapter.
+type Encoder func(w io.Writer, v any) error
+
+// jsonEncoder holds the Encoder used by RespondJSON; nil means the standard library encoder.
+var jsonEncoder atomic.Pointer[Encoder]
+
+// SetJSONEncoder replaces the encoder used by RespondJSON and RespondError for the whole process.
+// It is typically called once at startup; a nil encoder restores the encoding/json default.
+func SetJSONEncoder(enc Encoder) {
+	if enc == nil {
+		jsonEncoder.Store(nil)
+		return
+	}
+	jsonEncoder.Store(&enc)
+}
+
+// RespondJSON writes v as a JSON response with the given status code.
+// The body is encoded before anything is written, so an encoding failure produces a
+// 500 response instead of a truncated body; the encoding error is returned.
+func RespondJSON(w http.ResponseWriter, status int, v any) error {
+	var buf bytes.Buffer
+	if err := encodeJSON(&buf, v); err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return fmt.Errorf("httpx: error encoding response: %w", err)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("httpx: error writing response: %w", err)
+	}
+	return nil
+}
+
+// ErrorResponse is the JSON body written by RespondError.
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// RespondError writes {"error": message} as a JSON response with the given status code.
+func RespondError(w http.ResponseWriter, status int, message string) error {
+	return RespondJSON(w, status, ErrorResponse{Error: message})
+}
+
+func encodeJSON(w io.Writer, v any) error {
+	if enc := jsonEncoder.Load(); enc != nil {
+		return (*enc)(w, v)
+	}
+	return json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,73 @@
+package httpx
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRespondJSON(t *testing.T) {
+	tests := map[string]struct {
+		encoder         Encoder
+		write           func(w http.ResponseWriter) error
+		wantStatus      int
+		wantBody        string
+		wantErr         string
+		skipContentType bool
+	}{
+		"default-encoder": {
+			write:      func(w http.ResponseWriter) error { return RespondJSON(w, http.StatusCreated, map[string]int{"id": 7}) },
+			wantStatus: http.StatusCreated,
+			wantBody:   "{\"id\":7}\n",
+		},
+		"error-response": {
+			write:      func(w http.ResponseWriter) error { return RespondError(w, http.StatusNotFound, "todo not found") },
+			wantStatus: http.StatusNotFound,
+			wantBody:   "{\"error\":\"todo not found\"}\n",
+		},
+		"custom-encoder": {
+			encoder: func(w io.Writer, v any) error {
+				_, err := io.WriteString(w, `{"custom":true}`)
+				return err
+			},
+			write:      func(w http.ResponseWriter) error { return RespondJSON(w, http.StatusOK, struct{}{}) },
+			wantStatus: http.StatusOK,
+			wantBody:   `{"custom":true}`,
+		},
+		"encoding-failure": {
+			encoder:         func(io.Writer, any) error { return errors.New("boom") },
+			write:           func(w http.ResponseWriter) error { return RespondJSON(w, http.StatusOK, struct{}{}) },
+			wantStatus:      http.StatusInternalServerError,
+			wantBody:        "Internal Server Error\n",
+			wantErr:         "httpx: error encoding response: boom",
+			skipContentType: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			SetJSONEncoder(tt.encoder)
+			defer SetJSONEncoder(nil)
+
+			rec := httptest.NewRecorder()
+			err := tt.write(rec)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr) {
+				t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+			}
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, rec.Code)
+			}
+			if rec.Body.String() != tt.wantBody {
+				t.Fatalf("expected body %q, got %q", tt.wantBody, rec.Body.String())
+			}
+			if ct := rec.Header().Get("Content-Type"); !tt.skipContentType && ct != "application/json" {
+				t.Fatalf("expected application/json, got %q", ct)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package httpx
+
+// PagedResponse is a page of items with links to the neighboring page numbers.
+// NextPage and PreviousPage are nil when there is no such page.
+type PagedResponse[T any] struct {
+	Items        []T  `json:"items"`
+	Page         int  `json:"page"`
+	PageSize     int  `json:"pageSize"`
+	NextPage     *int `json:"nextPage,omitempty"`
+	PreviousPage *int `json:"previousPage,omitempty"`
+}
+
+// Paginate builds the response for page (1-based) of a listing.
+// hasMore reports whether items exist beyond this page, typically found by fetching pageSize+1
+// rows; a page with more than pageSize items is also treated as having more, and trimmed.
+// Pages below 1 are treated as page 1, and Items is never nil, so an empty page encodes as [].
+func Paginate[T any](items []T, page, pageSize int, hasMore bool) PagedResponse[T] {
+	page = max(page, 1)
+	if pageSize > 0 && len(items) > pageSize {
+		items = items[:pageSize]
+		hasMore = true
+	}
+	if items == nil {
+		items = []T{}
+	}
+
+	resp := PagedResponse[T]{
+		Items:    items,
+		Page:     page,
+		PageSize: pageSize,
+	}
+	if hasMore {
+		next := page + 1
+		resp.NextPage = &next
+	}
+	if page > 1 {
+		previous := page - 1
+		resp.PreviousPage = &previous
+	}
+	return resp
+}
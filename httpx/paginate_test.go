@@ -0,0 +1,81 @@
+package httpx
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPaginate(t *testing.T) {
+	tests := map[string]struct {
+		items        []string
+		page         int
+		pageSize     int
+		hasMore      bool
+		wantItems    int
+		wantPage     int
+		wantNext     int
+		wantPrevious int
+	}{
+		"first-page-has-no-previous": {
+			items: []string{"a", "b"}, page: 1, pageSize: 2, hasMore: true,
+			wantItems: 2, wantPage: 1, wantNext: 2,
+		},
+		"middle-page": {
+			items: []string{"c", "d"}, page: 2, pageSize: 2, hasMore: true,
+			wantItems: 2, wantPage: 2, wantNext: 3, wantPrevious: 1,
+		},
+		"last-page-has-no-next": {
+			items: []string{"e"}, page: 3, pageSize: 2,
+			wantItems: 1, wantPage: 3, wantPrevious: 2,
+		},
+		"empty-result": {
+			page: 1, pageSize: 2,
+			wantPage: 1,
+		},
+		"extra-item-is-trimmed-and-means-more": {
+			items: []string{"a", "b", "c"}, page: 1, pageSize: 2,
+			wantItems: 2, wantPage: 1, wantNext: 2,
+		},
+		"page-below-one-is-first-page": {
+			items: []string{"a"}, page: 0, pageSize: 2,
+			wantItems: 1, wantPage: 1,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := Paginate(tt.items, tt.page, tt.pageSize, tt.hasMore)
+			if got.Items == nil || len(got.Items) != tt.wantItems {
+				t.Fatalf("expected %d items, got %v", tt.wantItems, got.Items)
+			}
+			if got.Page != tt.wantPage || got.PageSize != tt.pageSize {
+				t.Fatalf("expected page %d of size %d, got %d of size %d", tt.wantPage, tt.pageSize, got.Page, got.PageSize)
+			}
+			assertPageLink(t, "next", got.NextPage, tt.wantNext)
+			assertPageLink(t, "previous", got.PreviousPage, tt.wantPrevious)
+		})
+	}
+}
+
+func assertPageLink(t *testing.T, name string, got *int, want int) {
+	t.Helper()
+	if want == 0 {
+		if got != nil {
+			t.Fatalf("expected no %s page, got %d", name, *got)
+		}
+		return
+	}
+	if got == nil || *got != want {
+		t.Fatalf("expected %s page %d, got %v", name, want, got)
+	}
+}
+
+func TestPaginate_JSON(t *testing.T) {
+	b, err := json.Marshal(Paginate([]int(nil), 1, 10, false))
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if want := `{"items":[],"page":1,"pageSize":10}`; string(b) != want {
+		t.Fatalf("expected %s, got %s", want, b)
+	}
+}
@@ -0,0 +1,88 @@
+// Package httpx provides HTTP helpers for components hosted by symbiont.
+package httpx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// SSEWriter writes Server-Sent Events to an HTTP response, flushing after each event.
+// It is not safe for concurrent use.
+type SSEWriter struct {
+	w   http.ResponseWriter
+	rc  *http.ResponseController
+	ctx context.Context
+}
+
+// NewSSEWriter prepares w for an event stream: it sets the text/event-stream headers,
+// writes the status, and flushes so the client sees the stream open.
+// The request's context tracks client disconnects.
+// Returns an error if w does not support flushing.
+func NewSSEWriter(w http.ResponseWriter, r *http.Request) (*SSEWriter, error) {
+	h := w.Header()
+	h.Set("Content-Type", "text/event-stream")
+	h.Set("Cache-Control", "no-cache")
+	h.Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	rc := http.NewResponseController(w)
+	if err := rc.Flush(); err != nil {
+		return nil, fmt.Errorf("httpx: response writer does not support streaming: %w", err)
+	}
+	return &SSEWriter{w: w, rc: rc, ctx: r.Context()}, nil
+}
+
+// Send writes a single event and flushes it.
+// An empty event name omits the event: line, so clients receive it as a "message" event.
+// Strings and byte slices are sent as-is, one data: line per line of text; other values are
+// marshaled to JSON. Returns the request context's error once the client has disconnected.
+func (s *SSEWriter) Send(event string, data any) error {
+	if err := s.ctx.Err(); err != nil {
+		return err
+	}
+	if strings.ContainsAny(event, "\r\n") {
+		return fmt.Errorf("httpx: event name %q must not contain line breaks", event)
+	}
+	payload, err := ssePayload(data)
+	if err != nil {
+		return fmt.Errorf("httpx: error encoding event data: %w", err)
+	}
+
+	var b strings.Builder
+	if event != "" {
+		fmt.Fprintf(&b, "event: %s\n", event)
+	}
+	for line := range strings.SplitSeq(payload, "\n") {
+		fmt.Fprintf(&b, "data: %s\n", line)
+	}
+	b.WriteString("\n")
+
+	if _, err := s.w.Write([]byte(b.String())); err != nil {
+		return fmt.Errorf("httpx: error writing event: %w", err)
+	}
+	if err := s.rc.Flush(); err != nil {
+		return fmt.Errorf("httpx: error flushing event: %w", err)
+	}
+	return nil
+}
+
+// Done returns a channel that is closed when the client disconnects.
+func (s *SSEWriter) Done() <-chan struct{} {
+	return s.ctx.Done()
+}
+
+// ssePayload converts event data to the text carried by data: lines.
+func ssePayload(data any) (string, error) {
+	switch v := data.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	default:
+		b, err := json.Marshal(v)
+		return string(b), err
+	}
+}
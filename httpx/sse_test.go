@@ -0,0 +1,97 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSSEWriter_Send(t *testing.T) {
+	tests := map[string]struct {
+		event    string
+		data     any
+		wantBody string
+		wantErr  string
+	}{
+		"named-json-event": {
+			event:    "token",
+			data:     map[string]int{"n": 1},
+			wantBody: "event: token\ndata: {\"n\":1}\n\n",
+		},
+		"unnamed-string-event": {
+			data:     "hello",
+			wantBody: "data: hello\n\n",
+		},
+		"multi-line-text": {
+			event:    "text",
+			data:     []byte("line 1\nline 2"),
+			wantBody: "event: text\ndata: line 1\ndata: line 2\n\n",
+		},
+		"unencodable-data": {
+			data:    func() {},
+			wantErr: "httpx: error encoding event data: json: unsupported type: func()",
+		},
+		"line-break-in-event-name": {
+			event:   "a\nb",
+			data:    "x",
+			wantErr: "httpx: event name \"a\\nb\" must not contain line breaks",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			sse, err := NewSSEWriter(rec, httptest.NewRequest(http.MethodGet, "/stream", nil))
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			err = sse.Send(tt.event, tt.data)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got := rec.Body.String(); got != tt.wantBody {
+				t.Fatalf("expected body %q, got %q", tt.wantBody, got)
+			}
+			if !rec.Flushed {
+				t.Fatal("expected the event to be flushed")
+			}
+			if ct := rec.Header().Get("Content-Type"); ct != "text/event-stream" {
+				t.Fatalf("expected text/event-stream, got %q", ct)
+			}
+		})
+	}
+}
+
+func TestSSEWriter_ClientDisconnect(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	req := httptest.NewRequest(http.MethodGet, "/stream", nil).WithContext(ctx)
+	sse, err := NewSSEWriter(httptest.NewRecorder(), req)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	cancel()
+	<-sse.Done()
+	if err := sse.Send("token", "late"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+// nonFlusher is a ResponseWriter that cannot flush.
+type nonFlusher struct{ http.ResponseWriter }
+
+func TestNewSSEWriter_RequiresFlusher(t *testing.T) {
+	_, err := NewSSEWriter(nonFlusher{httptest.NewRecorder()}, httptest.NewRequest(http.MethodGet, "/stream", nil))
+	if err == nil {
+		t.Fatal("expected an error for a writer that cannot flush")
+	}
+}
@@ -56,17 +56,21 @@ type StructFieldIteratorFunc func(fieldValue reflect.Value, structField reflect.
 
 // IterateStructFields calls the provided functions for each field in a struct pointer.
 // Functions are called in order for each field. Returns error if target is not a struct pointer or if any function fails.
+//
+// Fields of embedded structs, and of non-nil embedded struct pointers, are visited after the embedded
+// field itself, so tags on promoted fields are honored; targetType stays the outer struct pointer type.
+// Embedded interfaces are visited as a single field and never traversed, and nil embedded pointers are skipped.
 func IterateStructFields(target any, fns ...StructFieldIteratorFunc) error {
 	v := reflect.ValueOf(target)
 	if !IsPointerStruct(v) {
 		return fmt.Errorf("target must be a struct pointer, got '%s'", GetTypeName(v.Type()))
 	}
 	vtype := v.Type()
-	v = v.Elem()
-	t := v.Type()
-	for i := range v.NumField() {
+	// Fields are collected up front so callbacks always run at the same stack depth,
+	// which keeps caller attribution in introspection events stable for promoted fields.
+	for _, f := range collectFields(v.Elem(), nil) {
 		for _, fn := range fns {
-			if err := fn(v.Field(i), t.Field(i), vtype); err != nil {
+			if err := fn(f.value, f.structField, vtype); err != nil {
 				return err
 			}
 		}
@@ -74,6 +78,73 @@ func IterateStructFields(target any, fns ...StructFieldIteratorFunc) error {
 	return nil
 }
 
+// structFieldValue pairs a field value with its struct field metadata.
+type structFieldValue struct {
+	value       reflect.Value
+	structField reflect.StructField
+}
+
+// collectFields appends the fields of the struct value v to fields, each embedded struct followed by its own fields.
+func collectFields(v reflect.Value, fields []structFieldValue) []structFieldValue {
+	t := v.Type()
+	for i := range v.NumField() {
+		field, structField := v.Field(i), t.Field(i)
+		fields = append(fields, structFieldValue{value: field, structField: structField})
+		if !structField.Anonymous {
+			continue
+		}
+		switch {
+		case field.Kind() == reflect.Struct:
+			fields = collectFields(field, fields)
+		case IsPointerStruct(field):
+			fields = collectFields(field.Elem(), fields)
+		}
+	}
+	return fields
+}
+
+// FieldInfo describes a struct field carrying a given tag.
+type FieldInfo struct {
+	Name       string       // field name
+	Type       reflect.Type // field type
+	Tag        string       // value of the requested tag
+	Default    string       // value of the default tag, if any
+	HasDefault bool         // whether the field has a default tag
+}
+
+// TaggedFields lists the fields of target, a struct or struct pointer, that carry tag, in the order
+// IterateStructFields visits them, including promoted fields of embedded structs and of non-nil
+// embedded struct pointers. Fields are only inspected, never set, so tooling can enumerate declared
+// tags without injecting anything. Returns nil when target is not a struct or a non-nil struct pointer.
+func TaggedFields(target any, tag string) []FieldInfo {
+	v := reflect.ValueOf(target)
+	if IsPointerStruct(v) {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	var infos []FieldInfo
+	for _, f := range collectFields(v, nil) {
+		value, ok := f.structField.Tag.Lookup(tag)
+		if !ok {
+			continue
+		}
+		defaultValue, hasDefault := f.structField.Tag.Lookup(defaultTagName)
+		infos = append(infos, FieldInfo{
+			Name:       f.structField.Name,
+			Type:       f.structField.Type,
+			Tag:        value,
+			Default:    defaultValue,
+			HasDefault: hasDefault,
+		})
+	}
+	return infos
+}
+
+// defaultTagName is the struct tag key holding a field's default value, as read by the config package.
+const defaultTagName = "default"
+
 // SetFieldValue sets a struct field to the provided value.
 // Returns error if the field is not settable (e.g., unexported field).
 func SetFieldValue(field reflect.Value, structField reflect.StructField, value any) error {
@@ -118,6 +189,16 @@ func IsPointerStruct(v reflect.Value) bool {
 	return v.Kind() == reflect.Pointer && v.Elem().Kind() == reflect.Struct
 }
 
+// IsNil reports whether v is nil, including typed nil pointers, maps, slices, channels, and functions
+// stored in an interface.
+func IsNil(v any) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	return isTypeNullable(rv.Type()) && rv.IsNil()
+}
+
 // isTypeNullable checks if a type can be nil (pointers, slices, maps, channels, functions, interfaces).
 func isTypeNullable(t reflect.Type) bool {
 	switch t.Kind() {
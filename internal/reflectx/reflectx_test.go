@@ -93,6 +93,131 @@ func TestIterateStructFields(t *testing.T) {
 	}
 }
 
+type (
+	embeddedInner struct {
+		C int `tag:"c"`
+	}
+	embeddedPtr struct {
+		D int
+	}
+	embeddedIface interface{ Do() }
+	embeddedOuter struct {
+		A int
+		embeddedInner
+		*embeddedPtr
+		embeddedIface
+		B string
+	}
+)
+
+func TestIterateStructFields_Embedded(t *testing.T) {
+	tests := map[string]struct {
+		target   *embeddedOuter
+		expected []string
+	}{
+		"visits-promoted-fields-after-embedded-field": {
+			target:   &embeddedOuter{embeddedPtr: &embeddedPtr{}},
+			expected: []string{"A", "embeddedInner", "C", "embeddedPtr", "D", "embeddedIface", "B"},
+		},
+		"skips-nil-embedded-pointer": {
+			target:   &embeddedOuter{},
+			expected: []string{"A", "embeddedInner", "C", "embeddedPtr", "embeddedIface", "B"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var fields []string
+			err := IterateStructFields(tt.target, func(fieldValue reflect.Value, structField reflect.StructField, targetType reflect.Type) error {
+				fields = append(fields, structField.Name)
+				if targetType != reflect.TypeFor[*embeddedOuter]() {
+					t.Fatalf("expected target type of the outer struct, got %v", targetType)
+				}
+				if structField.Name == "C" {
+					fieldValue.SetInt(7)
+				}
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if !reflect.DeepEqual(tt.expected, fields) {
+				t.Fatalf("expected fields %v, got %v", tt.expected, fields)
+			}
+			if tt.target.C != 7 {
+				t.Fatalf("expected promoted field to be settable, got %d", tt.target.C)
+			}
+		})
+	}
+}
+
+func TestTaggedFields(t *testing.T) {
+	type (
+		inner struct {
+			Port int `config:"PORT" default:"8080"`
+		}
+		outer struct {
+			Host  string `config:"HOST"`
+			Dep   string `resolve:"db"`
+			Plain int
+			inner
+			*embeddedPtr `config:"PTR"`
+			Empty        string `config:"" default:""`
+		}
+	)
+
+	tests := map[string]struct {
+		target   any
+		tag      string
+		expected []FieldInfo
+	}{
+		"config-tags-with-embedded-fields": {
+			target: &outer{},
+			tag:    "config",
+			expected: []FieldInfo{
+				{Name: "Host", Type: reflect.TypeFor[string](), Tag: "HOST"},
+				{Name: "Port", Type: reflect.TypeFor[int](), Tag: "PORT", Default: "8080", HasDefault: true},
+				{Name: "embeddedPtr", Type: reflect.TypeFor[*embeddedPtr](), Tag: "PTR"},
+				{Name: "Empty", Type: reflect.TypeFor[string](), HasDefault: true},
+			},
+		},
+		"struct-value": {
+			target:   outer{},
+			tag:      "resolve",
+			expected: []FieldInfo{{Name: "Dep", Type: reflect.TypeFor[string](), Tag: "db"}},
+		},
+		"no-matching-tag": {
+			target: &outer{},
+			tag:    "context",
+		},
+		"nil-pointer": {
+			target: (*outer)(nil),
+			tag:    "config",
+		},
+		"not-a-struct": {
+			target: 42,
+			tag:    "config",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := TaggedFields(tt.target, tt.tag)
+			if !reflect.DeepEqual(tt.expected, got) {
+				t.Fatalf("expected fields %+v, got %+v", tt.expected, got)
+			}
+		})
+	}
+
+	t.Run("does-not-modify-target", func(t *testing.T) {
+		target := &outer{Host: "localhost"}
+		TaggedFields(target, "config")
+		if target.Host != "localhost" || target.embeddedPtr != nil {
+			t.Fatalf("expected target to be left untouched, got %+v", target)
+		}
+	})
+}
+
 func TestSetFieldValue(t *testing.T) {
 	type testStruct struct {
 		A int
@@ -164,6 +289,27 @@ func TestIsPointerStruct(t *testing.T) {
 	}
 }
 
+func TestIsNil(t *testing.T) {
+	type foo struct{}
+	var nilFoo *foo
+	var nilMap map[string]int
+	if !IsNil(nil) {
+		t.Fatal("expected untyped nil to be nil")
+	}
+	if !IsNil(nilFoo) {
+		t.Fatal("expected typed nil pointer to be nil")
+	}
+	if !IsNil(nilMap) {
+		t.Fatal("expected nil map to be nil")
+	}
+	if IsNil(&foo{}) {
+		t.Fatal("expected non-nil pointer to be non-nil")
+	}
+	if IsNil(foo{}) {
+		t.Fatal("expected struct value to be non-nil")
+	}
+}
+
 func Test_isTypeNullable(t *testing.T) {
 	if !isTypeNullable(reflect.TypeFor[*int]()) {
 		t.Fatal("expected pointer type to be nullable")
@@ -2,9 +2,15 @@ package symbiont
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 
+	"github.com/cleitonmarx/symbiont/config"
+	"github.com/cleitonmarx/symbiont/depend"
 	"github.com/cleitonmarx/symbiont/introspection"
+	"github.com/cleitonmarx/symbiont/introspection/mermaid"
 )
 
 // Introspector defines an interface for introspecting application runners, configuration and dependencies.
@@ -24,17 +30,92 @@ func (a *App) Introspect(i Introspector) *App {
 	return a
 }
 
+// IntrospectionSnapshot builds a fresh report from the current dependency events and configuration accesses.
+// Unlike introspectors, which receive a single report before runnables start, it can be called at any time,
+// for example from an admin endpoint, to include dependencies resolved lazily after startup
+// and the runnable exits recorded so far.
+func (a *App) IntrospectionSnapshot() introspection.Report {
+	return introspection.Report{
+		Configs:         config.IntrospectConfigAccesses(),
+		DeclaredConfigs: a.configDeclarations(),
+		Deps:            depend.GetEvents(),
+		Runners:         a.runnerInfos(),
+		Initializers:    a.initializerInfos(),
+		RunnableExits:   a.exits.events(),
+	}
+}
+
+// configDeclarations returns the config keys declared by initializers, hosted runnables, and introspectors,
+// in registration order, with each component's ConfigPrefixer prefix applied.
+func (a *App) configDeclarations() []introspection.ConfigDeclaration {
+	specs := a.hostedSpecs()
+	targets := make([]any, 0, len(a.initializers)+len(specs)+len(a.introspectors))
+	for _, init := range a.initializers {
+		targets = append(targets, init)
+	}
+	for _, rs := range specs {
+		for _, component := range hostedComponents(rs.original) {
+			targets = append(targets, component)
+		}
+	}
+	for _, i := range a.introspectors {
+		targets = append(targets, i)
+	}
+
+	var declared []introspection.ConfigDeclaration
+	for _, target := range targets {
+		ctx := context.Background()
+		if p, ok := target.(ConfigPrefixer); ok {
+			ctx = config.WithPrefix(ctx, p.ConfigPrefix())
+		}
+		// Components that are not struct pointers declare no keys.
+		keys, _ := config.DeclaredKeys(ctx, target)
+		declared = append(declared, keys...)
+	}
+	return declared
+}
+
+// IntrospectionHandler returns an http.Handler serving a fresh IntrospectionSnapshot on every request.
+// The report is written as JSON by default, or as a Mermaid graph in plain text with ?format=mermaid.
+// The handler does not depend on the request path, so it can be mounted on any route of any mux.
+func (a *App) IntrospectionHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		report := a.IntrospectionSnapshot()
+		switch format := r.URL.Query().Get("format"); format {
+		case "", "json":
+			body, err := json.Marshal(report)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(body)
+		case "mermaid":
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			_, _ = io.WriteString(w, mermaid.GenerateIntrospectionGraph(report))
+		default:
+			http.Error(w, fmt.Sprintf("unsupported format %q, use json or mermaid", format), http.StatusBadRequest)
+		}
+	})
+}
+
 // introspectSafe calls the provided Introspector's Introspect method safely,
 // recovering from panics and wrapping errors with context about the introspector.
 func introspectSafe(ctx context.Context, i Introspector, r introspection.Report) (err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			err = NewError(fmt.Errorf("panic in Introspect func: %v", r), i)
+			err = newPhaseError(fmt.Errorf("panic in Introspect func: %v", r), i, PhaseIntrospect)
 		}
 	}()
 	err = i.Introspect(ctx, r)
 	if err != nil {
-		err = NewError(err, i)
+		err = newPhaseError(err, i, PhaseIntrospect)
 	}
 	return err
 }
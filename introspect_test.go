@@ -3,27 +3,21 @@ package symbiont
 import (
 	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/cleitonmarx/symbiont/config"
+	"github.com/cleitonmarx/symbiont/config/configtest"
 	"github.com/cleitonmarx/symbiont/depend"
 	"github.com/cleitonmarx/symbiont/introspection"
 )
 
 var errTest = errors.New("test error")
 
-type mapProvider struct {
-	values map[string]string
-}
-
-func (m mapProvider) Get(ctx context.Context, name string) (string, error) {
-	if v, ok := m.values[name]; ok {
-		return v, nil
-	}
-	return "", errTest
-}
-
 type initForIntrospect struct{}
 
 func (initForIntrospect) Initialize(ctx context.Context) (context.Context, error) {
@@ -215,7 +209,7 @@ func TestApp_IntrospectProvidesReport(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			defer depend.ClearContainer()
 			defer config.ResetGlobalProvider()
-			config.SetGlobalProvider(mapProvider{values: map[string]string{"cfgKey": "val"}})
+			config.SetGlobalProvider(configtest.NewMapProvider(map[string]string{"cfgKey": "val"}))
 
 			hosted := c.host
 			if hosted == nil {
@@ -264,3 +258,220 @@ func TestApp_IntrospectProvidesReport(t *testing.T) {
 		})
 	}
 }
+
+func TestApp_IntrospectionSnapshot(t *testing.T) {
+	depend.ClearContainer()
+	config.ResetGlobalProvider()
+	defer func() { depend.ClearContainer(); config.ResetGlobalProvider() }()
+	config.SetGlobalProvider(configtest.NewMapProvider(map[string]string{"cfgKey": "val"}))
+
+	intro := &recorderIntrospector{}
+	app := NewApp().
+		Initialize(&initForIntrospect{}).
+		Host(&waitRunnable{done: make(chan struct{})}).
+		Introspect(intro)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errCh := app.RunAsync(ctx)
+	if err := app.WaitForReadiness(ctx, time.Second); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	before := app.IntrospectionSnapshot()
+	if len(before.Deps) != len(intro.report.Deps) {
+		t.Fatalf("expected snapshot to match startup report with %d deps, got %d", len(intro.report.Deps), len(before.Deps))
+	}
+	if len(before.Runners) != 1 || len(before.Initializers) != 1 {
+		t.Fatalf("expected 1 runner and 1 initializer, got %d and %d", len(before.Runners), len(before.Initializers))
+	}
+
+	// Lazily resolve a dependency and read config after startup.
+	if _, err := depend.Resolve[string](); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := config.Get[string](ctx, "cfgKey"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	after := app.IntrospectionSnapshot()
+	if len(after.Deps) != len(before.Deps)+1 {
+		t.Fatalf("expected 1 new dependency event, got %d", len(after.Deps)-len(before.Deps))
+	}
+	if len(after.Configs) != len(before.Configs)+1 {
+		t.Fatalf("expected 1 new config access, got %d", len(after.Configs)-len(before.Configs))
+	}
+
+	cancel()
+	if err := <-errCh; err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestApp_WiringAttributesResolutionToComponent(t *testing.T) {
+	depend.ClearContainer()
+	defer depend.ClearContainer()
+
+	err := NewApp().
+		Initialize(&depRegisterInitializer{value: "v"}).
+		Host(&resolveDepRun{}).
+		RunWithContext(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	for _, ev := range depend.GetEvents() {
+		if ev.Kind != introspection.DepResolved {
+			continue
+		}
+		if ev.Component != "*symbiont.resolveDepRun" {
+			t.Fatalf("expected resolution attributed to *symbiont.resolveDepRun, got %q", ev.Component)
+		}
+		if ev.Caller != (introspection.Caller{}) {
+			t.Fatalf("expected no framework caller for tag-based wiring, got %+v", ev.Caller)
+		}
+		return
+	}
+	t.Fatal("expected a resolve event")
+}
+
+func TestApp_IntrospectionHandler(t *testing.T) {
+	depend.ClearContainer()
+	defer depend.ClearContainer()
+	depend.Register("depVal")
+
+	app := NewApp().Host(&runForIntrospect{})
+
+	tests := map[string]struct {
+		method          string
+		target          string
+		wantStatus      int
+		wantContentType string
+		wantBody        string
+	}{
+		"json-by-default": {
+			method:          http.MethodGet,
+			target:          "/debug/wiring",
+			wantStatus:      http.StatusOK,
+			wantContentType: "application/json",
+			wantBody:        `"runners":[{"type":"*symbiont.runForIntrospect"}]`,
+		},
+		"mermaid-format": {
+			method:          http.MethodGet,
+			target:          "/?format=mermaid",
+			wantStatus:      http.StatusOK,
+			wantContentType: "text/plain; charset=utf-8",
+			wantBody:        "graph TD",
+		},
+		"unsupported-format": {
+			method:     http.MethodGet,
+			target:     "/?format=yaml",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `unsupported format "yaml"`,
+		},
+		"method-not-allowed": {
+			method:     http.MethodPost,
+			target:     "/",
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			app.IntrospectionHandler().ServeHTTP(rec, httptest.NewRequest(tt.method, tt.target, nil))
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, rec.Code)
+			}
+			if tt.wantContentType != "" && rec.Header().Get("Content-Type") != tt.wantContentType {
+				t.Fatalf("expected content type %q, got %q", tt.wantContentType, rec.Header().Get("Content-Type"))
+			}
+			if !strings.Contains(rec.Body.String(), tt.wantBody) {
+				t.Fatalf("expected body to contain %q, got %q", tt.wantBody, rec.Body.String())
+			}
+		})
+	}
+
+	// The handler reflects dependencies resolved after it was created.
+	handler := app.IntrospectionHandler()
+	if _, err := depend.Resolve[string](); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if !strings.Contains(rec.Body.String(), `"kind":"resolve"`) {
+		t.Fatalf("expected snapshot to include the lazy resolution, got %q", rec.Body.String())
+	}
+}
+
+type namedRunnable struct{ name string }
+
+func (n *namedRunnable) Name() string                  { return n.name }
+func (n *namedRunnable) Run(ctx context.Context) error { return nil }
+
+func TestApp_IntrospectionSnapshot_NamedRunners(t *testing.T) {
+	app := NewApp().Host(&namedRunnable{name: "public"}, &namedRunnable{name: "admin"}, &runCloser{})
+
+	var ids []string
+	for _, rn := range app.IntrospectionSnapshot().Runners {
+		ids = append(ids, rn.ID())
+	}
+	want := []string{"*symbiont.namedRunnable[public]", "*symbiont.namedRunnable[admin]", "*symbiont.runCloser"}
+	if !reflect.DeepEqual(want, ids) {
+		t.Fatalf("expected runners %v, got %v", want, ids)
+	}
+}
+
+type portConfigRunnable struct {
+	Port int `config:"PORT"`
+}
+
+func (*portConfigRunnable) Run(context.Context) error { return nil }
+
+type modeConfigRunnable struct {
+	Mode string `config:"MODE" default:"fast"`
+}
+
+func (*modeConfigRunnable) Run(context.Context) error { return nil }
+
+func TestApp_IntrospectionSnapshot_UnusedConfigKeys(t *testing.T) {
+	tests := map[string]struct {
+		values     map[string]string
+		wantErr    bool
+		wantUnused []introspection.ConfigDeclaration
+	}{
+		"all-declared-keys-read": {
+			values: map[string]string{"PORT": "8080"},
+		},
+		"wiring-stopped-before-later-components": {
+			values:  map[string]string{},
+			wantErr: true,
+			wantUnused: []introspection.ConfigDeclaration{
+				{Key: "PORT", Field: "Port", Component: "*symbiont.portConfigRunnable"},
+				{Key: "MODE", Field: "Mode", Component: "*symbiont.modeConfigRunnable"},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			depend.ClearContainer()
+			config.ResetGlobalProvider()
+			defer func() { depend.ClearContainer(); config.ResetGlobalProvider() }()
+			config.SetGlobalProvider(configtest.NewMapProvider(tt.values))
+
+			app := NewApp().Host(&portConfigRunnable{}, &modeConfigRunnable{})
+			if err := app.RunWithContext(context.Background()); (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+
+			report := app.IntrospectionSnapshot()
+			if len(report.DeclaredConfigs) != 2 {
+				t.Fatalf("expected 2 declared keys, got %+v", report.DeclaredConfigs)
+			}
+			if got := introspection.UnusedConfigKeys(report); !reflect.DeepEqual(tt.wantUnused, got) {
+				t.Fatalf("expected unused keys %+v, got %+v", tt.wantUnused, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package introspection
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ReportDiff lists what changed in the wiring between two reports.
+// Every list is sorted and free of duplicates, so a diff renders the same way on every run.
+type ReportDiff struct {
+	AddedDeps         []string `json:"addedDeps"`
+	RemovedDeps       []string `json:"removedDeps"`
+	AddedConfigKeys   []string `json:"addedConfigKeys"`
+	RemovedConfigKeys []string `json:"removedConfigKeys"`
+	AddedRunners      []string `json:"addedRunners"`
+	RemovedRunners    []string `json:"removedRunners"`
+}
+
+// Diff compares two reports and returns the dependencies, config keys, and runners
+// present in only one of them.
+// Dependencies are identified by their registrations, as "Type -> Impl" or "Type[name] -> Impl",
+// so swapping an implementation shows up as one removal and one addition.
+// Event order, callers, and providers are ignored.
+func Diff(old, new Report) ReportDiff {
+	var d ReportDiff
+	d.AddedDeps, d.RemovedDeps = diffSets(registeredDeps(old), registeredDeps(new))
+	d.AddedConfigKeys, d.RemovedConfigKeys = diffSets(configKeys(old), configKeys(new))
+	d.AddedRunners, d.RemovedRunners = diffSets(runnerIDs(old), runnerIDs(new))
+	return d
+}
+
+// IsEmpty reports whether the two compared reports have the same wiring.
+func (d ReportDiff) IsEmpty() bool {
+	return len(d.AddedDeps) == 0 && len(d.RemovedDeps) == 0 &&
+		len(d.AddedConfigKeys) == 0 && len(d.RemovedConfigKeys) == 0 &&
+		len(d.AddedRunners) == 0 && len(d.RemovedRunners) == 0
+}
+
+// String renders the diff as text, one "+" or "-" line per change grouped by section.
+// An empty diff renders as "no changes".
+func (d ReportDiff) String() string {
+	if d.IsEmpty() {
+		return "no changes"
+	}
+	var b strings.Builder
+	writeSection(&b, "dependencies", d.AddedDeps, d.RemovedDeps)
+	writeSection(&b, "config keys", d.AddedConfigKeys, d.RemovedConfigKeys)
+	writeSection(&b, "runners", d.AddedRunners, d.RemovedRunners)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func writeSection(b *strings.Builder, title string, added, removed []string) {
+	if len(added) == 0 && len(removed) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "%s:\n", title)
+	for _, s := range added {
+		fmt.Fprintf(b, "  + %s\n", s)
+	}
+	for _, s := range removed {
+		fmt.Fprintf(b, "  - %s\n", s)
+	}
+}
+
+// diffSets returns the sorted values only in new (added) and only in old (removed).
+func diffSets(old, new []string) (added, removed []string) {
+	for _, s := range new {
+		if !slices.Contains(old, s) {
+			added = append(added, s)
+		}
+	}
+	for _, s := range old {
+		if !slices.Contains(new, s) {
+			removed = append(removed, s)
+		}
+	}
+	return added, removed
+}
+
+// registeredDeps returns the sorted, unique registrations of a report.
+func registeredDeps(r Report) []string {
+	var deps []string
+	for _, ev := range r.Deps {
+		if ev.Kind != DepRegistered {
+			continue
+		}
+		key := ev.Type
+		if ev.Name != "" {
+			key += "[" + ev.Name + "]"
+		}
+		deps = append(deps, key+" -> "+ev.Impl)
+	}
+	return sortedUnique(deps)
+}
+
+// configKeys returns the sorted, unique config keys accessed in a report.
+func configKeys(r Report) []string {
+	keys := make([]string, 0, len(r.Configs))
+	for _, c := range r.Configs {
+		keys = append(keys, c.Key)
+	}
+	return sortedUnique(keys)
+}
+
+// runnerIDs returns the sorted, unique runner IDs of a report.
+func runnerIDs(r Report) []string {
+	ids := make([]string, 0, len(r.Runners))
+	for _, rn := range r.Runners {
+		ids = append(ids, rn.ID())
+	}
+	return sortedUnique(ids)
+}
+
+func sortedUnique(s []string) []string {
+	slices.Sort(s)
+	return slices.Compact(s)
+}
@@ -0,0 +1,96 @@
+package introspection
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	base := Report{
+		Configs: []ConfigAccess{{Key: "DB_HOST"}, {Key: "PORT"}, {Key: "PORT"}},
+		Deps: []DepEvent{
+			{Kind: DepRegistered, Type: "*sql.DB", Impl: "*sql.DB"},
+			{Kind: DepRegistered, Type: "store.Repo", Impl: "*store.Postgres"},
+			{Kind: DepResolved, Type: "store.Repo", Impl: "*store.Postgres"},
+		},
+		Runners: []RunnerInfo{{Type: "*api.Server"}},
+	}
+
+	tests := map[string]struct {
+		new      Report
+		wantDiff ReportDiff
+		wantText string
+	}{
+		"identical-wiring": {
+			new: Report{
+				Configs: []ConfigAccess{{Key: "PORT", Order: 9}, {Key: "DB_HOST"}},
+				Deps: []DepEvent{
+					{Kind: DepRegistered, Type: "store.Repo", Impl: "*store.Postgres", Order: 4},
+					{Kind: DepRegistered, Type: "*sql.DB", Impl: "*sql.DB"},
+				},
+				Runners: []RunnerInfo{{Type: "*api.Server"}},
+			},
+			wantText: "no changes",
+		},
+		"changed-wiring": {
+			new: Report{
+				Configs: []ConfigAccess{{Key: "PORT"}, {Key: "REDIS_URL"}},
+				Deps: []DepEvent{
+					{Kind: DepRegistered, Type: "*sql.DB", Impl: "*sql.DB"},
+					{Kind: DepRegistered, Type: "store.Repo", Impl: "*store.Redis"},
+					{Kind: DepRegistered, Type: "store.Repo", Name: "audit", Impl: "*store.Postgres"},
+				},
+				Runners: []RunnerInfo{{Type: "*api.Server"}, {Type: "*api.Server", Name: "admin"}, {Type: "*worker.Consumer"}},
+			},
+			wantDiff: ReportDiff{
+				AddedDeps:         []string{"store.Repo -> *store.Redis", "store.Repo[audit] -> *store.Postgres"},
+				RemovedDeps:       []string{"store.Repo -> *store.Postgres"},
+				AddedConfigKeys:   []string{"REDIS_URL"},
+				RemovedConfigKeys: []string{"DB_HOST"},
+				AddedRunners:      []string{"*api.Server[admin]", "*worker.Consumer"},
+			},
+			wantText: "dependencies:\n" +
+				"  + store.Repo -> *store.Redis\n" +
+				"  + store.Repo[audit] -> *store.Postgres\n" +
+				"  - store.Repo -> *store.Postgres\n" +
+				"config keys:\n" +
+				"  + REDIS_URL\n" +
+				"  - DB_HOST\n" +
+				"runners:\n" +
+				"  + *api.Server[admin]\n" +
+				"  + *worker.Consumer",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := Diff(base, tt.new)
+			if !reflect.DeepEqual(tt.wantDiff, got) {
+				t.Fatalf("expected diff %+v, got %+v", tt.wantDiff, got)
+			}
+			if got.String() != tt.wantText {
+				t.Fatalf("expected text:\n%s\ngot:\n%s", tt.wantText, got.String())
+			}
+		})
+	}
+}
+
+func TestDiff_GoldenReportRoundTrip(t *testing.T) {
+	report := Report{
+		Configs: []ConfigAccess{{Key: "PORT"}},
+		Deps:    []DepEvent{{Kind: DepRegistered, Type: "string", Impl: "string"}},
+		Runners: []RunnerInfo{{Type: "*api.Server", Component: reflect.TypeFor[int]()}},
+	}
+	golden, err := json.Marshal(report)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var decoded Report
+	if err := json.Unmarshal(golden, &decoded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if d := Diff(decoded, report); !d.IsEmpty() {
+		t.Fatalf("expected a decoded report to match the original, got:\n%s", d)
+	}
+}
@@ -1,7 +1,10 @@
 package mermaid
 
 import (
+	"cmp"
 	"fmt"
+	"path"
+	"slices"
 	"strings"
 
 	"github.com/cleitonmarx/symbiont/introspection"
@@ -40,8 +43,82 @@ var (
 	styleTypeName       = Style{Color: "green", FontSize: "11px", IsHtml: true}
 )
 
+// graphConfig holds configuration options for graph generation.
+type graphConfig struct {
+	depFilter func(introspection.DepEvent) bool
+	legend    bool
+}
+
+// GraphOption configures GenerateIntrospectionGraph behavior.
+type GraphOption func(*graphConfig)
+
+// WithDepFilter keeps only the dependency events for which keep returns true.
+// Filtered dependencies are left out of the graph together with their edges, which is useful
+// for rendering focused subgraphs of large apps. Configs, initializers, and runnables are not filtered.
+func WithDepFilter(keep func(introspection.DepEvent) bool) GraphOption {
+	return func(cfg *graphConfig) {
+		cfg.depFilter = keep
+	}
+}
+
+// WithLegend adds a disconnected legend subgraph that explains the node colors, so shared
+// diagrams are self-documenting. The legend uses the same styles as the graph nodes.
+func WithLegend(enabled bool) GraphOption {
+	return func(cfg *graphConfig) {
+		cfg.legend = enabled
+	}
+}
+
+// legendNodes returns one node per node style, labeled with its meaning.
+func legendNodes() []Node {
+	entries := []struct {
+		id, label string
+		style     Style
+	}{
+		{"legend_config", "Config key", styleConfig},
+		{"legend_dep_used", "Dependency", styleDepUsed},
+		{"legend_dep_unused", "Unused dependency", styleDepUnused},
+		{"legend_initializer", "Initializer", styleInitializer},
+		{"legend_caller", "Caller", styleCaller},
+		{"legend_runnable", "Runnable", styleRunnable},
+		{"legend_app", "App", styleApp},
+	}
+	nodes := make([]Node, 0, len(entries))
+	for _, e := range entries {
+		nodes = append(nodes, Node{ID: e.id, Label: e.label, Style: e.style})
+	}
+	return nodes
+}
+
+// MatchImpl returns a dependency filter that matches events whose implementation type
+// matches pattern, using path.Match syntax. A leading "*" of pointer types is ignored,
+// so "usecases.*" matches both "usecases.CreateTodo" and "*usecases.CreateTodo".
+// Malformed patterns match nothing.
+func MatchImpl(pattern string) func(introspection.DepEvent) bool {
+	return func(ev introspection.DepEvent) bool {
+		matched, err := path.Match(pattern, strings.TrimPrefix(ev.Impl, "*"))
+		return err == nil && matched
+	}
+}
+
 // GenerateIntrospectionGraph generates a Mermaid graph representation of the introspection report.
-func GenerateIntrospectionGraph(r introspection.Report) string {
+func GenerateIntrospectionGraph(r introspection.Report, opts ...GraphOption) string {
+	var cfg graphConfig
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&cfg)
+		}
+	}
+	if cfg.depFilter != nil {
+		deps := make([]introspection.DepEvent, 0, len(r.Deps))
+		for _, ev := range r.Deps {
+			if cfg.depFilter(ev) {
+				deps = append(deps, ev)
+			}
+		}
+		r.Deps = deps
+	}
+
 	var edges []Edge
 	nodeMap := make(map[string]Node)
 	depHasCaller := make(map[string]bool)
@@ -74,8 +151,8 @@ func GenerateIntrospectionGraph(r introspection.Report) string {
 	// --- Runnable ---
 	buildRunnerGraph(r.Runners, nodeMap, &edges, appNodeID)
 
-	// Remove duplicates and preserve order
-	order := buildOrderedNodeIDs(nodeMap)
+	// Order nodes deterministically
+	order := buildOrderedNodeIDs(nodeMap, r)
 	// --- Set styles using declarative Style struct ---
 	applyNodeStyles(nodeMap, depHasCaller)
 
@@ -88,6 +165,9 @@ func GenerateIntrospectionGraph(r introspection.Report) string {
 		Nodes: nodes,
 		Edges: edges,
 	}
+	if cfg.legend {
+		g.Legend = legendNodes()
+	}
 	return g.RenderTD()
 }
 
@@ -138,15 +218,7 @@ func buildDependencyGraph(nodeMap map[string]Node, depHasCaller map[string]bool,
 			}
 		}
 		if ev.Kind == introspection.DepResolved {
-			toCaller, callerType := canonicalCaller(ev.Caller.Func, initializerTypes)
-			if toCaller == "" && ev.Component != "" {
-				toCaller = ev.Component
-				if _, ok := initializerTypes[ev.Component]; ok {
-					callerType = NodeInitializer
-				} else {
-					callerType = NodeCaller
-				}
-			}
+			toCaller, callerType := resolvedConsumer(ev, initializerTypes)
 			if toCaller == "" {
 				toCaller = ev.Type
 			}
@@ -161,6 +233,9 @@ func buildDependencyGraph(nodeMap map[string]Node, depHasCaller map[string]bool,
 					if callerType == NodeInitializer {
 						return []string{Subline(styleTypeName, "%s <b>Initializer</b>", emojiInitializer)}
 					}
+					if ev.Caller.File == "" {
+						return nil
+					}
 					return []string{Subline(styleCodeLoc, "%s(%s:%d)", emojiCodeLocation, ev.Caller.File, ev.Caller.Line)}
 				}(),
 			}.ToHTML()
@@ -198,6 +273,19 @@ func buildDependencyGraph(nodeMap map[string]Node, depHasCaller map[string]bool,
 	}
 }
 
+// resolvedConsumer returns the node that consumed a resolved dependency.
+// The component being wired takes precedence over the calling function, so struct-tag
+// resolutions point at the owning runnable or initializer rather than the code that wired it.
+func resolvedConsumer(ev introspection.DepEvent, initializerTypes map[string]struct{}) (string, NodeType) {
+	if ev.Component == "" {
+		return canonicalCaller(ev.Caller.Func, initializerTypes)
+	}
+	if _, ok := initializerTypes[ev.Component]; ok {
+		return ev.Component, NodeInitializer
+	}
+	return ev.Component, NodeCaller
+}
+
 // dependencyNodeID generates a unique node ID for a dependency event.
 func dependencyNodeID(ev introspection.DepEvent) string {
 	return fmt.Sprintf("%s::%s::%s", ev.Type, ev.Name, ev.Impl)
@@ -209,7 +297,11 @@ func buildConfigGraph(nodeMap map[string]Node, initializerTypes map[string]struc
 		configKey := k.Key
 		var sublines []string
 		if k.Provider != "" {
-			sublines = append(sublines, Subline(styleConfigProvider, "%s %s", emojiConfigProvider, k.Provider))
+			sourceKind := "inferred"
+			if k.AuthoritativeSource {
+				sourceKind = "authoritative"
+			}
+			sublines = append(sublines, Subline(styleConfigProvider, "%s %s (%s)", emojiConfigProvider, k.Provider, sourceKind))
 		}
 		if k.UsedDefault {
 			sublines = append(sublines, Subline(styleConfigDefault, "default"))
@@ -267,16 +359,25 @@ func buildConfigGraph(nodeMap map[string]Node, initializerTypes map[string]struc
 }
 
 // buildRunnerGraph builds runnable nodes and returns their IDs in order.
+// Named runners get one node per instance; dependencies and configs wired into their type
+// are connected to every named instance of that type.
 func buildRunnerGraph(runnerInfos []introspection.RunnerInfo, nodeMap map[string]Node, edges *[]Edge, appNodeId string) {
+	namedByType := make(map[string][]string)
 	for _, runnableInfo := range runnerInfos {
-		runnableID := runnableInfo.Type
+		runnableID := runnableInfo.ID()
+		var sublines []string
+		if runnableInfo.Name != "" {
+			sublines = append(sublines, Subline(styleName, "name: %s", runnableInfo.Name))
+			if !slices.Contains(namedByType[runnableInfo.Type], runnableID) {
+				namedByType[runnableInfo.Type] = append(namedByType[runnableInfo.Type], runnableID)
+			}
+		}
+		sublines = append(sublines, Subline(styleTypeName, "%s <b>Runnable</b>", emojiRunnable))
 		label := LabelBuilder{
-			Label:    runnableID,
+			Label:    runnableInfo.Type,
 			FontSize: 16,
 			Bold:     true,
-			SubLines: []string{
-				Subline(styleTypeName, "%s <b>Runnable</b>", emojiRunnable),
-			},
+			SubLines: sublines,
 		}.ToHTML()
 		nodeMap[runnableID] = Node{
 			ID:    runnableID,
@@ -286,6 +387,35 @@ func buildRunnerGraph(runnerInfos []introspection.RunnerInfo, nodeMap map[string
 		}
 		*edges = append(*edges, Edge{From: runnableID, To: appNodeId, Arrow: "---"})
 	}
+	retargetNamedRunners(namedByType, nodeMap, edges)
+}
+
+// retargetNamedRunners moves edges that point at a runnable type onto its named instances.
+// Wiring events only record the component type, so each named instance receives a copy of the
+// edge, and the type's consumer node is dropped. Types that are also hosted unnamed are left as is.
+func retargetNamedRunners(namedByType map[string][]string, nodeMap map[string]Node, edges *[]Edge) {
+	for typ, ids := range namedByType {
+		if n, ok := nodeMap[typ]; !ok || n.Type != NodeCaller {
+			continue
+		}
+		delete(nodeMap, typ)
+
+		kept := (*edges)[:0]
+		var moved []Edge
+		for _, e := range *edges {
+			if e.To != typ {
+				kept = append(kept, e)
+				continue
+			}
+			for _, id := range ids {
+				retargeted := Edge{From: e.From, To: id, Arrow: e.Arrow}
+				if !slices.Contains(moved, retargeted) {
+					moved = append(moved, retargeted)
+				}
+			}
+		}
+		*edges = append(kept, moved...)
+	}
 }
 
 func buildInitializerGraph(initializers []introspection.InitializerInfo, nodeMap map[string]Node) {
@@ -350,46 +480,59 @@ func applyNodeStyles(nodeMap map[string]Node, depHasCaller map[string]bool) {
 	}
 }
 
-// buildOrderedNodeIDs returns a deduplicated, ordered list of node IDs for rendering.
-// The order is: deps/configs -> callers/initializers -> runnables -> app.
-func buildOrderedNodeIDs(nodeMap map[string]Node) []string {
-	var depConfigOrder, callerOrder, runnableOrderOrdered []string
-	var appNode string
-	for id, node := range nodeMap {
-		switch node.Type {
-		case NodeDependency, NodeConfig:
-			depConfigOrder = append(depConfigOrder, id)
-		case NodeCaller, NodeInitializer:
-			callerOrder = append(callerOrder, id)
-		case NodeRunnable:
-			runnableOrderOrdered = append(runnableOrderOrdered, id)
-		case NodeApp:
-			appNode = id
+// buildOrderedNodeIDs returns the node IDs in a stable order for rendering.
+// The order is: configs -> deps -> initializers -> callers -> runnables -> app.
+// Configs and deps follow their recorded access/event order, initializers and runnables follow
+// the report order, and ties (such as callers) are broken by node ID, so the same report always
+// renders the same graph.
+func buildOrderedNodeIDs(nodeMap map[string]Node, r introspection.Report) []string {
+	rank := make(map[string]int)
+	setRank := func(id string, order int) {
+		if current, ok := rank[id]; !ok || order < current {
+			rank[id] = order
 		}
 	}
-
-	seen := make(map[string]struct{})
-	order := []string{}
-	for _, id := range depConfigOrder {
-		if _, ok := seen[id]; !ok {
-			order = append(order, id)
-			seen[id] = struct{}{}
-		}
+	for _, c := range r.Configs {
+		setRank(c.Key, c.Order)
 	}
-	for _, id := range callerOrder {
-		if _, ok := seen[id]; !ok {
-			order = append(order, id)
-			seen[id] = struct{}{}
-		}
+	for _, ev := range r.Deps {
+		setRank(dependencyNodeID(ev), ev.Order)
 	}
-	for _, id := range runnableOrderOrdered {
-		if _, ok := seen[id]; !ok {
-			order = append(order, id)
-			seen[id] = struct{}{}
-		}
+	for i, init := range r.Initializers {
+		setRank(init.Type, i)
 	}
-	if appNode != "" {
-		order = append(order, appNode)
+	for i, rn := range r.Runners {
+		setRank(rn.ID(), i)
+	}
+
+	order := make([]string, 0, len(nodeMap))
+	for id := range nodeMap {
+		order = append(order, id)
 	}
+	slices.SortFunc(order, func(a, b string) int {
+		return cmp.Or(
+			cmp.Compare(nodeTypeRank(nodeMap[a].Type), nodeTypeRank(nodeMap[b].Type)),
+			cmp.Compare(rank[a], rank[b]),
+			strings.Compare(a, b),
+		)
+	})
 	return order
 }
+
+// nodeTypeRank returns the rendering position of a node type.
+func nodeTypeRank(t NodeType) int {
+	switch t {
+	case NodeConfig:
+		return 0
+	case NodeDependency:
+		return 1
+	case NodeInitializer:
+		return 2
+	case NodeCaller:
+		return 3
+	case NodeRunnable:
+		return 4
+	default:
+		return 5
+	}
+}
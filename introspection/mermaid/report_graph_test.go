@@ -11,7 +11,7 @@ func TestGenerateIntrospectionGraph_Coverage(t *testing.T) {
 	report := introspection.Report{
 		Configs: []introspection.ConfigAccess{
 			// Config with provider and used default false
-			{Key: "cfg", Provider: "provider", UsedDefault: false, Caller: introspection.Caller{Func: "initLogger", File: "f", Line: 1}},
+			{Key: "cfg", Provider: "provider", AuthoritativeSource: true, UsedDefault: false, Caller: introspection.Caller{Func: "initLogger", File: "f", Line: 1}},
 			// Config with no provider and used default true
 			{Key: "cfgDefault", Provider: "", UsedDefault: true, Caller: introspection.Caller{Func: "initOther", File: "f2", Line: 2}},
 			// Config with provider but no matching initializer
@@ -65,6 +65,12 @@ func TestGenerateIntrospectionGraph_Coverage(t *testing.T) {
 		t.Fatal("expected config edges in graph output")
 	}
 
+	// Provider sublines distinguish reported sources from inferred ones
+	if !strings.Contains(out, emojiConfigProvider+" provider (authoritative)") ||
+		!strings.Contains(out, emojiConfigProvider+" provider2 (inferred)") {
+		t.Fatal("expected config provider sublines to show whether the source is authoritative")
+	}
+
 	// Runner to Symbiont
 	if !strings.Contains(out, "run1 --- SymbiontApp") || !strings.Contains(out, "run2 --- SymbiontApp") {
 		t.Fatal("expected runnable edges to app")
@@ -161,3 +167,194 @@ func TestGenerateIntrospectionGraph_DistinctInitializersWithSameNameAcrossPackag
 		t.Fatalf("did not expect beta initializer to be linked to %q", alphaDep.Type)
 	}
 }
+
+func TestGenerateIntrospectionGraph_ResolvedByWiredComponent(t *testing.T) {
+	repo := introspection.DepEvent{Type: "TodoRepository", Impl: "*postgres.Repo"}
+	report := introspection.Report{
+		Deps: []introspection.DepEvent{
+			{Kind: introspection.DepRegistered, Type: repo.Type, Impl: repo.Impl, Caller: introspection.Caller{Func: "initDB", File: "f", Line: 1}},
+			// Tag-based wiring performed by the App: no caller, only the consuming component.
+			{Kind: introspection.DepResolved, Type: repo.Type, Impl: repo.Impl, Component: "*app.TodoAppServer"},
+			// Resolved through depend.ResolveStruct from user code: the component still wins over the caller.
+			{Kind: introspection.DepResolved, Type: repo.Type, Impl: repo.Impl, Component: "*app.Worker", Caller: introspection.Caller{Func: "main.main", File: "main.go", Line: 9}},
+		},
+		Runners: []introspection.RunnerInfo{{Type: "*app.TodoAppServer"}, {Type: "*app.Worker"}},
+	}
+
+	out := GenerateIntrospectionGraph(report)
+
+	for _, consumer := range []string{"*app.TodoAppServer", "*app.Worker"} {
+		edge := sanitizeID(dependencyNodeID(repo)) + " -.-> " + sanitizeID(consumer)
+		if !strings.Contains(out, edge) {
+			t.Fatalf("expected edge %q in graph output:\n%s", edge, out)
+		}
+	}
+	if strings.Contains(out, "-.-> "+sanitizeID("main.main")) {
+		t.Fatalf("expected no edge to the wiring function, got:\n%s", out)
+	}
+}
+
+func TestGenerateIntrospectionGraph_NamedRunners(t *testing.T) {
+	logger := introspection.DepEvent{Type: "*slog.Logger", Impl: "*slog.Logger"}
+	report := introspection.Report{
+		Configs: []introspection.ConfigAccess{{Key: "HTTP_PORT", Component: "*app.HTTPWorker"}},
+		Deps: []introspection.DepEvent{
+			{Kind: introspection.DepRegistered, Type: logger.Type, Impl: logger.Impl, Caller: introspection.Caller{Func: "initLogger"}},
+			{Kind: introspection.DepResolved, Type: logger.Type, Impl: logger.Impl, Component: "*app.HTTPWorker"},
+			{Kind: introspection.DepResolved, Type: logger.Type, Impl: logger.Impl, Component: "*app.HTTPWorker"},
+		},
+		Runners: []introspection.RunnerInfo{
+			{Type: "*app.HTTPWorker", Name: "public"},
+			{Type: "*app.HTTPWorker", Name: "admin"},
+		},
+	}
+
+	out := GenerateIntrospectionGraph(report)
+
+	for _, id := range []string{"*app.HTTPWorker[public]", "*app.HTTPWorker[admin]"} {
+		for _, edge := range []string{
+			sanitizeID(id) + " --- SymbiontApp",
+			sanitizeID(dependencyNodeID(logger)) + " -.-> " + sanitizeID(id),
+			"HTTP_PORT -.-> " + sanitizeID(id),
+		} {
+			if strings.Count(out, edge+"\n") != 1 {
+				t.Fatalf("expected edge %q once in graph output:\n%s", edge, out)
+			}
+		}
+	}
+	if strings.Contains(out, "\t"+sanitizeID("*app.HTTPWorker")+"[") {
+		t.Fatalf("expected no node for the bare runnable type, got:\n%s", out)
+	}
+	if !strings.Contains(out, "name: admin") {
+		t.Fatalf("expected the instance name in the runnable label, got:\n%s", out)
+	}
+}
+
+func TestGenerateIntrospectionGraph_StableOrder(t *testing.T) {
+	report := introspection.Report{
+		Configs: []introspection.ConfigAccess{
+			{Key: "ZETA", Order: 1, Component: "*app.Server"},
+			{Key: "ALPHA", Order: 2, Component: "*app.Server"},
+			{Key: "MID", Order: 3, Component: "*app.Worker"},
+		},
+		Deps: []introspection.DepEvent{
+			{Kind: introspection.DepRegistered, Type: "z.Store", Impl: "*z.Store", Order: 1, Caller: introspection.Caller{Func: "app.(*initDB).Initialize"}},
+			{Kind: introspection.DepRegistered, Type: "a.Cache", Impl: "*a.Cache", Order: 2, Caller: introspection.Caller{Func: "app.(*initDB).Initialize"}},
+			{Kind: introspection.DepResolved, Type: "a.Cache", Impl: "*a.Cache", Order: 3, Component: "*app.Worker"},
+			{Kind: introspection.DepResolved, Type: "z.Store", Impl: "*z.Store", Order: 4, Component: "*app.Server"},
+		},
+		Runners:      []introspection.RunnerInfo{{Type: "*app.Worker"}, {Type: "*app.Server"}},
+		Initializers: []introspection.InitializerInfo{{Type: "*app.initDB"}},
+	}
+
+	want := GenerateIntrospectionGraph(report)
+	for range 20 {
+		if got := GenerateIntrospectionGraph(report); got != want {
+			t.Fatalf("expected identical output across runs, got:\n%s\nwant:\n%s", got, want)
+		}
+	}
+
+	nodeLine := func(id string) int {
+		return strings.Index(want, "\t"+sanitizeID(id)+"[")
+	}
+	ordered := []string{
+		"ZETA", "ALPHA", "MID",
+		dependencyNodeID(introspection.DepEvent{Type: "z.Store", Impl: "*z.Store"}),
+		dependencyNodeID(introspection.DepEvent{Type: "a.Cache", Impl: "*a.Cache"}),
+		"*app.initDB",
+	}
+	for i := 1; i < len(ordered); i++ {
+		prev, cur := nodeLine(ordered[i-1]), nodeLine(ordered[i])
+		if prev < 0 || cur < 0 || prev > cur {
+			t.Fatalf("expected node %q before %q in:\n%s", ordered[i-1], ordered[i], want)
+		}
+	}
+}
+
+func TestGenerateIntrospectionGraph_DepFilter(t *testing.T) {
+	createTodo := introspection.DepEvent{Type: "usecases.CreateTodo", Impl: "*usecases.CreateTodoImpl"}
+	repo := introspection.DepEvent{Type: "domain.TodoRepository", Impl: "*postgres.Repository"}
+	report := introspection.Report{
+		Deps: []introspection.DepEvent{
+			{Kind: introspection.DepRegistered, Type: createTodo.Type, Impl: createTodo.Impl, Caller: introspection.Caller{Func: "initUsecases"}},
+			{Kind: introspection.DepResolved, Type: createTodo.Type, Impl: createTodo.Impl, Component: "*app.Server"},
+			{Kind: introspection.DepRegistered, Type: repo.Type, Impl: repo.Impl, Caller: introspection.Caller{Func: "initDB"}},
+			{Kind: introspection.DepResolved, Type: repo.Type, Impl: repo.Impl, Component: "*usecases.CreateTodoImpl"},
+		},
+		Runners: []introspection.RunnerInfo{{Type: "*app.Server"}},
+	}
+
+	tests := map[string]struct {
+		opts        []GraphOption
+		wantNodes   []string
+		unwantNodes []string
+	}{
+		"no-filter": {
+			wantNodes: []string{dependencyNodeID(createTodo), dependencyNodeID(repo), "initDB"},
+		},
+		"include-by-impl-package": {
+			opts:        []GraphOption{WithDepFilter(MatchImpl("usecases.*"))},
+			wantNodes:   []string{dependencyNodeID(createTodo), "initUsecases", "*app.Server"},
+			unwantNodes: []string{dependencyNodeID(repo), "initDB"},
+		},
+		"exclude-by-impl-package": {
+			opts: []GraphOption{WithDepFilter(func(ev introspection.DepEvent) bool {
+				return !MatchImpl("postgres.*")(ev)
+			})},
+			wantNodes:   []string{dependencyNodeID(createTodo)},
+			unwantNodes: []string{dependencyNodeID(repo), "initDB"},
+		},
+		"malformed-pattern-matches-nothing": {
+			opts:        []GraphOption{WithDepFilter(MatchImpl("usecases.["))},
+			wantNodes:   []string{"*app.Server"},
+			unwantNodes: []string{dependencyNodeID(createTodo), dependencyNodeID(repo)},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			out := GenerateIntrospectionGraph(report, tt.opts...)
+			for _, id := range tt.wantNodes {
+				if !strings.Contains(out, "\t"+sanitizeID(id)+"[") {
+					t.Fatalf("expected node %q in graph output:\n%s", id, out)
+				}
+			}
+			for _, id := range tt.unwantNodes {
+				if strings.Contains(out, sanitizeID(id)) {
+					t.Fatalf("expected node %q to be filtered out of:\n%s", id, out)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateIntrospectionGraph_Legend(t *testing.T) {
+	report := introspection.Report{Runners: []introspection.RunnerInfo{{Type: "*app.Server"}}}
+
+	if out := GenerateIntrospectionGraph(report); strings.Contains(out, "subgraph Legend") {
+		t.Fatalf("expected no legend by default:\n%s", out)
+	}
+	if out := GenerateIntrospectionGraph(report, WithLegend(false)); strings.Contains(out, "subgraph Legend") {
+		t.Fatalf("expected no legend when disabled:\n%s", out)
+	}
+
+	out := GenerateIntrospectionGraph(report, WithLegend(true))
+	if !strings.Contains(out, "\tsubgraph Legend\n") || !strings.Contains(out, "\tend\n") {
+		t.Fatalf("expected legend subgraph in:\n%s", out)
+	}
+	for _, n := range legendNodes() {
+		if !strings.Contains(out, "\t\t"+n.ID+"[\""+n.Label+"\"]") {
+			t.Fatalf("expected legend node %q in:\n%s", n.ID, out)
+		}
+		style := "style " + n.ID + " " + n.Style.ToCSS()
+		if !strings.Contains(out, style) {
+			t.Fatalf("expected %q in:\n%s", style, out)
+		}
+		if strings.Contains(out, "--> "+n.ID) || strings.Contains(out, n.ID+" -->") {
+			t.Fatalf("expected legend node %q to be disconnected:\n%s", n.ID, out)
+		}
+	}
+	if !strings.Contains(out, `legend_dep_unused["Unused dependency"]`) {
+		t.Fatalf("expected unused dependency legend entry in:\n%s", out)
+	}
+}
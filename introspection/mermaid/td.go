@@ -2,6 +2,7 @@ package mermaid
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 )
@@ -42,9 +43,11 @@ type Edge struct {
 }
 
 // Graph represents a Mermaid graph with nodes and edges.
+// Legend nodes, when present, are rendered in a separate, disconnected "Legend" subgraph.
 type Graph struct {
-	Nodes []Node
-	Edges []Edge
+	Nodes  []Node
+	Edges  []Edge
+	Legend []Node
 }
 
 // Style represents the style of a node in the graph.
@@ -179,6 +182,14 @@ func (g *Graph) RenderTD() string {
 		}
 	}
 
+	if len(g.Legend) > 0 {
+		b.WriteString("	subgraph Legend\n")
+		for _, n := range g.Legend {
+			fmt.Fprintf(&b, "		%s[\"%s\"]\n", sanitizeID(n.ID), n.Label)
+		}
+		b.WriteString("	end\n")
+	}
+
 	// Render edges (sorted for determinism)
 	edges := make([]Edge, len(g.Edges))
 	copy(edges, g.Edges)
@@ -199,7 +210,7 @@ func (g *Graph) RenderTD() string {
 	}
 
 	// Render styles
-	for _, n := range g.Nodes {
+	for _, n := range append(slices.Clone(g.Nodes), g.Legend...) {
 		id := sanitizeID(n.ID)
 		if n.Style.ToCSS() != "" {
 			fmt.Fprintf(&b, "	style %s %s\n", id, n.Style.ToCSS())
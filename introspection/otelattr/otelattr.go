@@ -0,0 +1,69 @@
+// Package otelattr exports an introspection report as OpenTelemetry attributes.
+// It lives in its own package so the core framework does not depend on OpenTelemetry.
+package otelattr
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cleitonmarx/symbiont/introspection"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ToOTelAttributes summarizes the wired topology of a report as attributes suitable for
+// a resource or a startup span: runnables, initializers, registered dependencies, and config keys.
+// Lists are deduplicated and sorted so the attributes are stable across runs.
+func ToOTelAttributes(r introspection.Report) []attribute.KeyValue {
+	runnables := make([]string, 0, len(r.Runners))
+	for _, runner := range r.Runners {
+		runnables = append(runnables, runner.ID())
+	}
+	initializers := make([]string, 0, len(r.Initializers))
+	for _, init := range r.Initializers {
+		initializers = append(initializers, init.Type)
+	}
+	var dependencies []string
+	for _, ev := range r.Deps {
+		if ev.Kind != introspection.DepRegistered {
+			continue
+		}
+		dep := ev.Type
+		if ev.Name != "" {
+			dep = fmt.Sprintf("%s[%s]", ev.Type, ev.Name)
+		}
+		dependencies = append(dependencies, dep)
+	}
+	configKeys := make([]string, 0, len(r.Configs))
+	for _, access := range r.Configs {
+		configKeys = append(configKeys, access.Key)
+	}
+
+	runnables = sortedUnique(runnables)
+	initializers = sortedUnique(initializers)
+	dependencies = sortedUnique(dependencies)
+	configKeys = sortedUnique(configKeys)
+
+	return []attribute.KeyValue{
+		attribute.StringSlice("symbiont.runnables", runnables),
+		attribute.Int("symbiont.runnables.count", len(runnables)),
+		attribute.StringSlice("symbiont.initializers", initializers),
+		attribute.Int("symbiont.initializers.count", len(initializers)),
+		attribute.StringSlice("symbiont.dependencies", dependencies),
+		attribute.Int("symbiont.dependencies.count", len(dependencies)),
+		attribute.StringSlice("symbiont.config.keys", configKeys),
+		attribute.Int("symbiont.config.keys.count", len(configKeys)),
+	}
+}
+
+// sortedUnique sorts values and removes duplicates, returning an empty non-nil slice for no values.
+func sortedUnique(values []string) []string {
+	sort.Strings(values)
+	out := make([]string, 0, len(values))
+	for i, v := range values {
+		if i > 0 && v == values[i-1] {
+			continue
+		}
+		out = append(out, v)
+	}
+	return out
+}
@@ -0,0 +1,63 @@
+package otelattr
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont/introspection"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestToOTelAttributes(t *testing.T) {
+	tests := map[string]struct {
+		report introspection.Report
+		want   map[attribute.Key]attribute.Value
+	}{
+		"empty-report": {
+			report: introspection.Report{},
+			want: map[attribute.Key]attribute.Value{
+				"symbiont.runnables":          attribute.StringSliceValue([]string{}),
+				"symbiont.runnables.count":    attribute.IntValue(0),
+				"symbiont.dependencies.count": attribute.IntValue(0),
+				"symbiont.config.keys":        attribute.StringSliceValue([]string{}),
+			},
+		},
+		"deduplicated-and-sorted": {
+			report: introspection.Report{
+				Runners:      []introspection.RunnerInfo{{Type: "*app.Worker"}, {Type: "*app.Server"}},
+				Initializers: []introspection.InitializerInfo{{Type: "*app.InitDB"}},
+				Deps: []introspection.DepEvent{
+					{Kind: introspection.DepRegistered, Type: "*sql.DB"},
+					{Kind: introspection.DepRegistered, Type: "*sql.DB", Name: "replica"},
+					{Kind: introspection.DepResolved, Type: "*sql.DB"},
+					{Kind: introspection.DepResolved, Type: "Unregistered"},
+				},
+				Configs: []introspection.ConfigAccess{{Key: "PORT"}, {Key: "DB_DSN"}, {Key: "PORT"}},
+			},
+			want: map[attribute.Key]attribute.Value{
+				"symbiont.runnables":          attribute.StringSliceValue([]string{"*app.Server", "*app.Worker"}),
+				"symbiont.runnables.count":    attribute.IntValue(2),
+				"symbiont.initializers":       attribute.StringSliceValue([]string{"*app.InitDB"}),
+				"symbiont.initializers.count": attribute.IntValue(1),
+				"symbiont.dependencies":       attribute.StringSliceValue([]string{"*sql.DB", "*sql.DB[replica]"}),
+				"symbiont.dependencies.count": attribute.IntValue(2),
+				"symbiont.config.keys":        attribute.StringSliceValue([]string{"DB_DSN", "PORT"}),
+				"symbiont.config.keys.count":  attribute.IntValue(2),
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := make(map[attribute.Key]attribute.Value)
+			for _, kv := range ToOTelAttributes(tt.report) {
+				got[kv.Key] = kv.Value
+			}
+			for key, want := range tt.want {
+				if !reflect.DeepEqual(want.AsInterface(), got[key].AsInterface()) {
+					t.Fatalf("expected %s=%v, got %v", key, want.AsInterface(), got[key].AsInterface())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,383 @@
+// Package plantuml renders introspection reports as PlantUML component diagrams.
+//
+// The diagram uses the same node categories and edges as the Mermaid graph, so teams whose
+// architecture docs are built with PlantUML can consume the same wiring information.
+package plantuml
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cleitonmarx/symbiont/introspection"
+)
+
+// nodeKind is the category of a node in the diagram.
+type nodeKind int
+
+const (
+	kindConfig nodeKind = iota
+	kindDependency
+	kindInitializer
+	kindCaller
+	kindRunnable
+	kindApp
+)
+
+// stereotype returns the PlantUML stereotype used to style nodes of the kind.
+func (k nodeKind) stereotype() string {
+	switch k {
+	case kindConfig:
+		return "Config"
+	case kindDependency:
+		return "Dependency"
+	case kindInitializer:
+		return "Initializer"
+	case kindRunnable:
+		return "Runnable"
+	case kindApp:
+		return "App"
+	default:
+		return "Caller"
+	}
+}
+
+const (
+	appNodeID       = "SymbiontApp"
+	unusedStereo    = "UnusedDependency"
+	arrowRegister   = "--o"
+	arrowResolve    = "..>"
+	arrowAttachment = "--"
+)
+
+// skinparams colors each stereotype like the corresponding Mermaid node style.
+const skinparams = `skinparam componentStyle rectangle
+skinparam component {
+  BackgroundColor<<Config>> #f1f7d2
+  BorderColor<<Config>> #a7c957
+  BackgroundColor<<Dependency>> #d6fff9
+  BorderColor<<Dependency>> #2ec4b6
+  BackgroundColor<<UnusedDependency>> #fce1e1
+  BorderColor<<UnusedDependency>> #a60202
+  BackgroundColor<<Initializer>> #f0f0f0
+  BorderColor<<Initializer>> #373636
+  BackgroundColor<<Caller>> #fff3e0
+  BorderColor<<Caller>> #f57c00
+  BackgroundColor<<Runnable>> #f1e8ff
+  BorderColor<<Runnable>> #7b2cbf
+  BackgroundColor<<App>> #0f56c4
+  BorderColor<<App>> #68a4eb
+  FontColor<<App>> #ffffff
+}
+`
+
+type node struct {
+	id       string
+	label    string
+	sublines []string
+	kind     nodeKind
+}
+
+type edge struct {
+	from  string
+	to    string
+	arrow string
+}
+
+// diagram collects the nodes and edges of a report before rendering.
+type diagram struct {
+	nodes            map[string]node
+	edges            []edge
+	depHasConsumer   map[string]bool
+	initializerTypes map[string]struct{}
+}
+
+// Generate renders the introspection report as a PlantUML component diagram.
+// Configs, dependencies, initializers, callers, runnables, and the app are drawn as
+// components with one stereotype per category; unused dependencies get their own stereotype.
+// The output is deterministic for a given report.
+func Generate(r introspection.Report) string {
+	d := diagram{
+		nodes:            make(map[string]node),
+		depHasConsumer:   make(map[string]bool),
+		initializerTypes: make(map[string]struct{}, len(r.Initializers)),
+	}
+	for _, init := range r.Initializers {
+		d.initializerTypes[init.Type] = struct{}{}
+	}
+
+	d.nodes[appNodeID] = node{id: appNodeID, label: "Symbiont App", kind: kindApp}
+	d.addConfigs(r.Configs)
+	d.addInitializers(r.Initializers)
+	d.addDependencies(r.Deps)
+	d.addRunners(r.Runners)
+
+	return d.render(r)
+}
+
+// addConfigs adds one node per config key, connected to the code that read it.
+func (d *diagram) addConfigs(configs []introspection.ConfigAccess) {
+	for _, c := range configs {
+		var sublines []string
+		if c.Provider != "" {
+			sourceKind := "inferred"
+			if c.AuthoritativeSource {
+				sourceKind = "authoritative"
+			}
+			sublines = append(sublines, fmt.Sprintf("provider: %s (%s)", c.Provider, sourceKind))
+		}
+		if c.UsedDefault {
+			sublines = append(sublines, "default")
+		}
+		d.nodes[c.Key] = node{id: c.Key, label: c.Key, sublines: sublines, kind: kindConfig}
+
+		caller, kind := d.canonicalCaller(c.Caller.Func)
+		if caller == "" && c.Component != "" {
+			caller, kind = d.component(c.Component)
+		}
+		if caller == "" {
+			caller, kind = "unknown caller", kindCaller
+		}
+		d.addConsumer(caller, kind)
+		d.edges = append(d.edges, edge{from: c.Key, to: caller, arrow: arrowResolve})
+	}
+}
+
+// addInitializers adds one node per registered initializer.
+func (d *diagram) addInitializers(initializers []introspection.InitializerInfo) {
+	for _, init := range initializers {
+		d.nodes[init.Type] = node{id: init.Type, label: init.Type, kind: kindInitializer}
+	}
+}
+
+// addDependencies adds dependency nodes, the code that registered them, and their consumers.
+func (d *diagram) addDependencies(deps []introspection.DepEvent) {
+	for _, ev := range deps {
+		id := dependencyNodeID(ev)
+		switch ev.Kind {
+		case introspection.DepRegistered:
+			d.nodes[id] = dependencyNode(id, ev)
+			if ev.Caller.Func != "" {
+				caller, kind := d.canonicalCaller(ev.Caller.Func)
+				d.addConsumer(caller, kind)
+				d.edges = append(d.edges, edge{from: caller, to: id, arrow: arrowRegister})
+			}
+		case introspection.DepResolved:
+			consumer, kind := d.resolvedConsumer(ev)
+			if consumer == "" {
+				consumer = ev.Type
+			}
+			d.addConsumer(consumer, kind)
+			if _, ok := d.nodes[id]; !ok {
+				d.nodes[id] = dependencyNode(id, ev)
+			}
+			d.depHasConsumer[id] = true
+			d.edges = append(d.edges, edge{from: id, to: consumer, arrow: arrowResolve})
+		}
+	}
+}
+
+// addRunners adds one node per runnable instance, attached to the app node.
+// Edges that point at the type of a named runnable are moved onto its named instances.
+func (d *diagram) addRunners(runners []introspection.RunnerInfo) {
+	namedByType := make(map[string][]string)
+	for _, rn := range runners {
+		id := rn.ID()
+		var sublines []string
+		if rn.Name != "" {
+			sublines = append(sublines, "name: "+rn.Name)
+			if !slices.Contains(namedByType[rn.Type], id) {
+				namedByType[rn.Type] = append(namedByType[rn.Type], id)
+			}
+		}
+		d.nodes[id] = node{id: id, label: rn.Type, sublines: sublines, kind: kindRunnable}
+		d.edges = append(d.edges, edge{from: id, to: appNodeID, arrow: arrowAttachment})
+	}
+
+	for typ, ids := range namedByType {
+		if n, ok := d.nodes[typ]; !ok || n.kind != kindCaller {
+			continue
+		}
+		delete(d.nodes, typ)
+
+		kept := d.edges[:0]
+		var moved []edge
+		for _, e := range d.edges {
+			if e.to != typ {
+				kept = append(kept, e)
+				continue
+			}
+			for _, id := range ids {
+				retargeted := edge{from: e.from, to: id, arrow: e.arrow}
+				if !slices.Contains(moved, retargeted) {
+					moved = append(moved, retargeted)
+				}
+			}
+		}
+		d.edges = append(kept, moved...)
+	}
+}
+
+// addConsumer adds a caller or initializer node unless a node with the same ID exists.
+func (d *diagram) addConsumer(id string, kind nodeKind) {
+	if _, ok := d.nodes[id]; ok {
+		return
+	}
+	d.nodes[id] = node{id: id, label: id, kind: kind}
+}
+
+// resolvedConsumer returns the node that consumed a resolved dependency, preferring the
+// component being wired over the calling function.
+func (d *diagram) resolvedConsumer(ev introspection.DepEvent) (string, nodeKind) {
+	if ev.Component == "" {
+		return d.canonicalCaller(ev.Caller.Func)
+	}
+	return d.component(ev.Component)
+}
+
+// component returns the node for a wired component type.
+func (d *diagram) component(typ string) (string, nodeKind) {
+	if _, ok := d.initializerTypes[typ]; ok {
+		return typ, kindInitializer
+	}
+	return typ, kindCaller
+}
+
+// canonicalCaller maps a caller function to the initializer it belongs to, if any.
+func (d *diagram) canonicalCaller(caller string) (string, nodeKind) {
+	normalized := strings.ReplaceAll(caller, ".(*", ".")
+	normalized = strings.ReplaceAll(normalized, ")", "")
+	for initType := range d.initializerTypes {
+		base := strings.TrimPrefix(initType, "*")
+		if normalized == initType || normalized == base || strings.HasPrefix(normalized, base+".") {
+			return initType, kindInitializer
+		}
+	}
+	return caller, kindCaller
+}
+
+func dependencyNode(id string, ev introspection.DepEvent) node {
+	var sublines []string
+	if ev.Name != "" {
+		sublines = append(sublines, "name: "+ev.Name)
+	}
+	if ev.Type != ev.Impl {
+		sublines = append(sublines, "impl: "+ev.Impl)
+	}
+	return node{id: id, label: ev.Type, sublines: sublines, kind: kindDependency}
+}
+
+// dependencyNodeID generates a unique node ID for a dependency event.
+func dependencyNodeID(ev introspection.DepEvent) string {
+	return fmt.Sprintf("%s::%s::%s", ev.Type, ev.Name, ev.Impl)
+}
+
+// render writes the diagram in PlantUML syntax.
+func (d *diagram) render(r introspection.Report) string {
+	order := d.orderedNodeIDs(r)
+	aliases := make(map[string]string, len(order))
+	used := make(map[string]bool, len(order))
+	for _, id := range order {
+		alias := sanitizeID(id)
+		for i := 2; used[alias]; i++ {
+			alias = fmt.Sprintf("%s_%d", sanitizeID(id), i)
+		}
+		used[alias] = true
+		aliases[id] = alias
+	}
+
+	var b strings.Builder
+	b.WriteString("@startuml\n")
+	b.WriteString(skinparams)
+	b.WriteString("\n")
+	for _, id := range order {
+		n := d.nodes[id]
+		stereotype := n.kind.stereotype()
+		if n.kind == kindDependency && !d.depHasConsumer[id] {
+			stereotype = unusedStereo
+		}
+		fmt.Fprintf(&b, "component \"%s\" as %s <<%s>>\n", nodeLabel(n), aliases[id], stereotype)
+	}
+
+	edges := slices.Clone(d.edges)
+	slices.SortFunc(edges, func(a, b edge) int {
+		return cmp.Or(strings.Compare(a.from, b.from), strings.Compare(a.to, b.to), strings.Compare(a.arrow, b.arrow))
+	})
+	edges = slices.Compact(edges)
+	if len(edges) > 0 {
+		b.WriteString("\n")
+	}
+	for _, e := range edges {
+		fmt.Fprintf(&b, "%s %s %s\n", aliases[e.from], e.arrow, aliases[e.to])
+	}
+	b.WriteString("@enduml\n")
+	return b.String()
+}
+
+// orderedNodeIDs returns the node IDs in the same stable order as the Mermaid graph:
+// configs -> deps -> initializers -> callers -> runnables -> app.
+func (d *diagram) orderedNodeIDs(r introspection.Report) []string {
+	rank := make(map[string]int)
+	setRank := func(id string, order int) {
+		if current, ok := rank[id]; !ok || order < current {
+			rank[id] = order
+		}
+	}
+	for _, c := range r.Configs {
+		setRank(c.Key, c.Order)
+	}
+	for _, ev := range r.Deps {
+		setRank(dependencyNodeID(ev), ev.Order)
+	}
+	for i, init := range r.Initializers {
+		setRank(init.Type, i)
+	}
+	for i, rn := range r.Runners {
+		setRank(rn.ID(), i)
+	}
+
+	order := make([]string, 0, len(d.nodes))
+	for id := range d.nodes {
+		order = append(order, id)
+	}
+	slices.SortFunc(order, func(a, b string) int {
+		return cmp.Or(
+			cmp.Compare(d.nodes[a].kind, d.nodes[b].kind),
+			cmp.Compare(rank[a], rank[b]),
+			strings.Compare(a, b),
+		)
+	})
+	return order
+}
+
+// nodeLabel joins the node label and its sublines with PlantUML line breaks.
+func nodeLabel(n node) string {
+	parts := make([]string, 0, len(n.sublines)+1)
+	parts = append(parts, escape(n.label))
+	for _, s := range n.sublines {
+		parts = append(parts, "<size:11>"+escape(s)+"</size>")
+	}
+	return strings.Join(parts, "\\n")
+}
+
+// escape keeps labels from terminating the quoted PlantUML string.
+func escape(s string) string {
+	return strings.ReplaceAll(s, `"`, `'`)
+}
+
+// sanitizeID turns a node ID into a valid PlantUML alias.
+func sanitizeID(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '*':
+			b.WriteString("ptr_")
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_':
+			b.WriteRune(r)
+		default:
+			b.WriteByte('_')
+		}
+	}
+	return b.String()
+}
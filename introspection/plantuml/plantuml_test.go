@@ -0,0 +1,115 @@
+package plantuml
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont/introspection"
+)
+
+func TestGenerate(t *testing.T) {
+	report := introspection.Report{
+		Configs: []introspection.ConfigAccess{
+			{Key: "DB_URL", Provider: "EnvVarProvider", AuthoritativeSource: true, Caller: introspection.Caller{Func: "app.(*initDB).Initialize"}, Order: 0},
+			{Key: "PORT", UsedDefault: true, Component: "*app.Server", Order: 1},
+			{Key: "ORPHAN", Provider: "vault", Order: 2},
+		},
+		Deps: []introspection.DepEvent{
+			{Kind: introspection.DepRegistered, Type: "app.Store", Impl: "*app.PGStore", Caller: introspection.Caller{Func: "app.(*initDB).Initialize"}, Order: 0},
+			{Kind: introspection.DepRegistered, Type: "app.Cache", Name: "local", Impl: "app.Cache", Caller: introspection.Caller{Func: "main.main"}, Order: 1},
+			{Kind: introspection.DepResolved, Type: "app.Store", Impl: "*app.PGStore", Component: "*app.Server", Order: 2},
+		},
+		Initializers: []introspection.InitializerInfo{{Type: "*app.initDB"}},
+		Runners: []introspection.RunnerInfo{
+			{Type: "*app.Server", Name: "public"},
+			{Type: "*app.Server", Name: "admin"},
+		},
+	}
+
+	out := Generate(report)
+
+	tests := map[string]struct {
+		want string
+	}{
+		"header":                 {want: "@startuml\n"},
+		"footer":                 {want: "@enduml\n"},
+		"app":                    {want: `component "Symbiont App" as SymbiontApp <<App>>`},
+		"authoritative-config":   {want: `component "DB_URL\n<size:11>provider: EnvVarProvider (authoritative)</size>" as DB_URL <<Config>>`},
+		"default-config":         {want: `component "PORT\n<size:11>default</size>" as PORT <<Config>>`},
+		"inferred-config":        {want: `<size:11>provider: vault (inferred)</size>`},
+		"used-dependency":        {want: `component "app.Store\n<size:11>impl: *app.PGStore</size>" as app_Store____ptr_app_PGStore <<Dependency>>`},
+		"unused-dependency":      {want: `component "app.Cache\n<size:11>name: local</size>" as app_Cache__local__app_Cache <<UnusedDependency>>`},
+		"initializer":            {want: `component "*app.initDB" as ptr_app_initDB <<Initializer>>`},
+		"caller":                 {want: `component "main.main" as main_main <<Caller>>`},
+		"named-runnable":         {want: `component "*app.Server\n<size:11>name: public</size>" as ptr_app_Server_public_ <<Runnable>>`},
+		"initializer-registers":  {want: "ptr_app_initDB --o app_Store____ptr_app_PGStore\n"},
+		"caller-registers":       {want: "main_main --o app_Cache__local__app_Cache\n"},
+		"config-to-initializer":  {want: "DB_URL ..> ptr_app_initDB\n"},
+		"config-to-named-runner": {want: "PORT ..> ptr_app_Server_admin_\n"},
+		"dep-to-named-runner":    {want: "app_Store____ptr_app_PGStore ..> ptr_app_Server_public_\n"},
+		"config-without-caller":  {want: "ORPHAN ..> unknown_caller\n"},
+		"runner-to-app":          {want: "ptr_app_Server_admin_ -- SymbiontApp\n"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if !strings.Contains(out, tt.want) {
+				t.Fatalf("expected output to contain %q, got:\n%s", tt.want, out)
+			}
+		})
+	}
+
+	if strings.Contains(out, `as ptr_app_Server <<`) {
+		t.Fatalf("expected named runnable type to be retargeted onto its instances, got:\n%s", out)
+	}
+	if out != Generate(report) {
+		t.Fatal("expected deterministic output")
+	}
+}
+
+func TestGenerate_NodeOrder(t *testing.T) {
+	report := introspection.Report{
+		Configs:      []introspection.ConfigAccess{{Key: "KEY", Caller: introspection.Caller{Func: "main.main"}}},
+		Deps:         []introspection.DepEvent{{Kind: introspection.DepRegistered, Type: "Dep", Impl: "Dep", Caller: introspection.Caller{Func: "main.main"}}},
+		Initializers: []introspection.InitializerInfo{{Type: "*app.Init"}},
+		Runners:      []introspection.RunnerInfo{{Type: "*app.Worker"}},
+	}
+
+	out := Generate(report)
+
+	order := []string{"<<Config>>", "<<UnusedDependency>>", "<<Initializer>>", "<<Caller>>", "<<Runnable>>", "<<App>>"}
+	last := -1
+	for _, stereotype := range order {
+		idx := strings.Index(out, stereotype+"\n")
+		if idx < last {
+			t.Fatalf("expected %s to be rendered after the previous category, got:\n%s", stereotype, out)
+		}
+		last = idx
+	}
+}
+
+func TestGenerate_EmptyReport(t *testing.T) {
+	out := Generate(introspection.Report{})
+	want := "@startuml\n" + skinparams + "\ncomponent \"Symbiont App\" as SymbiontApp <<App>>\n@enduml\n"
+	if out != want {
+		t.Fatalf("unexpected output:\n%s", out)
+	}
+}
+
+func TestSanitizeID(t *testing.T) {
+	tests := map[string]struct {
+		in   string
+		want string
+	}{
+		"plain":   {in: "main", want: "main"},
+		"pointer": {in: "*app.Server", want: "ptr_app_Server"},
+		"named":   {in: "app.Server[public]", want: "app_Server_public_"},
+		"spaces":  {in: "unknown caller", want: "unknown_caller"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := sanitizeID(tt.in); got != tt.want {
+				t.Fatalf("sanitizeID(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
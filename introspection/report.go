@@ -3,24 +3,43 @@ package introspection
 import (
 	"encoding/json"
 	"reflect"
+	"time"
 )
 
 // Report aggregates introspection data for configs, dependencies, and runners.
 type Report struct {
-	Configs      []ConfigAccess    `json:"configs"`
-	Deps         []DepEvent        `json:"deps"`
-	Runners      []RunnerInfo      `json:"runners"`
-	Initializers []InitializerInfo `json:"initializers"`
+	Configs         []ConfigAccess      `json:"configs"`
+	DeclaredConfigs []ConfigDeclaration `json:"declaredConfigs,omitempty"`
+	Deps            []DepEvent          `json:"deps"`
+	Runners         []RunnerInfo        `json:"runners"`
+	Initializers    []InitializerInfo   `json:"initializers"`
+	// RunnableExits lists the runnable exits recorded while the app ran, oldest first.
+	RunnableExits []RunnableExitEvent `json:"runnableExits,omitempty"`
 }
 
 // ConfigAccess captures a single configuration key access.
+// Time and Changed are only set on entries of the config audit log.
 type ConfigAccess struct {
-	Key         string `json:"key"`
-	Provider    string `json:"provider"`
-	UsedDefault bool   `json:"usedDefault"`
-	Caller      Caller `json:"caller"`
-	Component   string `json:"component"`
-	Order       int    `json:"order"`
+	Key      string `json:"key"`
+	Provider string `json:"provider"`
+	// AuthoritativeSource reports that Provider was named by the provider through GetWithSource,
+	// such as the sub-provider of a CompositeProvider that answered, or is the name a named provider
+	// was registered under, rather than inferred from the provider's type name.
+	AuthoritativeSource bool      `json:"authoritativeSource,omitempty"`
+	UsedDefault         bool      `json:"usedDefault"`
+	Caller              Caller    `json:"caller"`
+	Component           string    `json:"component"`
+	Order               int       `json:"order"`
+	Time                time.Time `json:"time,omitzero"`
+	Changed             bool      `json:"changed,omitempty"` // the value differs from the previous read of the key
+}
+
+// ConfigDeclaration captures a configuration key declared by a config-tagged struct field,
+// whether or not the key was ever read.
+type ConfigDeclaration struct {
+	Key       string `json:"key"`
+	Field     string `json:"field"`
+	Component string `json:"component"`
 }
 
 // DepEventKind describes the type of dependency event.
@@ -45,9 +64,40 @@ type DepEvent struct {
 // RunnerInfo describes a runnable that was registered with the app.
 type RunnerInfo struct {
 	Type      string       // type name
+	Name      string       // optional instance name, set when the runnable implements Named
 	Component reflect.Type // raw type if needed for reflection
 }
 
+// ID identifies the runner instance: its type, or "Type[name]" when the runner is named.
+func (r RunnerInfo) ID() string {
+	if r.Name == "" {
+		return r.Type
+	}
+	return r.Type + "[" + r.Name + "]"
+}
+
+// RunnableExitReason describes why a runnable's Run method returned.
+type RunnableExitReason string
+
+const (
+	// ExitReturned means Run returned nil while its context was still active.
+	ExitReturned RunnableExitReason = "return"
+	// ExitCanceled means Run returned after its context was done, either with nil or the context's error.
+	ExitCanceled RunnableExitReason = "canceled"
+	// ExitError means Run returned an error or panicked.
+	ExitError RunnableExitReason = "error"
+)
+
+// RunnableExitEvent records a single return from a runnable's Run method.
+// Short durations with error exits point at a flapping runnable.
+type RunnableExitEvent struct {
+	Type     string             `json:"type"`
+	Name     string             `json:"name,omitempty"` // optional instance name, set when the runnable implements Named
+	Duration time.Duration      `json:"duration"`
+	Reason   RunnableExitReason `json:"reason"`
+	Error    string             `json:"error,omitempty"`
+}
+
 // InitializerInfo describes an initializer registered with the app.
 type InitializerInfo struct {
 	Type      string       // type name
@@ -64,15 +114,18 @@ type Caller struct {
 // SerializableReport is a JSON-friendly representation of Report.
 // It omits reflection-heavy fields that do not marshal cleanly.
 type SerializableReport struct {
-	Configs      []ConfigAccess                `json:"configs"`
-	Deps         []DepEvent                    `json:"deps"`
-	Runners      []SerializableRunnerInfo      `json:"runners"`
-	Initializers []SerializableInitializerInfo `json:"initializers"`
+	Configs         []ConfigAccess                `json:"configs"`
+	DeclaredConfigs []ConfigDeclaration           `json:"declaredConfigs,omitempty"`
+	Deps            []DepEvent                    `json:"deps"`
+	Runners         []SerializableRunnerInfo      `json:"runners"`
+	Initializers    []SerializableInitializerInfo `json:"initializers"`
+	RunnableExits   []RunnableExitEvent           `json:"runnableExits,omitempty"`
 }
 
 // SerializableRunnerInfo is a JSON-friendly representation of RunnerInfo.
 type SerializableRunnerInfo struct {
 	Type string `json:"type"`
+	Name string `json:"name,omitempty"`
 }
 
 // SerializableInitializerInfo is a JSON-friendly representation of InitializerInfo.
@@ -84,17 +137,19 @@ type SerializableInitializerInfo struct {
 func (r Report) ToSerializable() SerializableReport {
 	runners := make([]SerializableRunnerInfo, 0, len(r.Runners))
 	for _, rn := range r.Runners {
-		runners = append(runners, SerializableRunnerInfo{Type: rn.Type})
+		runners = append(runners, SerializableRunnerInfo{Type: rn.Type, Name: rn.Name})
 	}
 	initializers := make([]SerializableInitializerInfo, 0, len(r.Initializers))
 	for _, init := range r.Initializers {
 		initializers = append(initializers, SerializableInitializerInfo{Type: init.Type})
 	}
 	return SerializableReport{
-		Configs:      r.Configs,
-		Deps:         r.Deps,
-		Runners:      runners,
-		Initializers: initializers,
+		Configs:         r.Configs,
+		DeclaredConfigs: r.DeclaredConfigs,
+		Deps:            r.Deps,
+		Runners:         runners,
+		Initializers:    initializers,
+		RunnableExits:   r.RunnableExits,
 	}
 }
 
@@ -35,6 +35,16 @@ func TestReport_MarshalJSON(t *testing.T) {
 			},
 			expectedJson: `{"configs":[{"key":"foo","provider":"prov","usedDefault":false,"caller":{"func":"","file":"","line":0},"component":"","order":1}],"deps":[{"kind":"register","type":"string","name":"dep","impl":"impl","caller":{"func":"","file":"","line":0},"component":"","order":2}],"runners":[{"type":"myRunner"}],"initializers":[{"type":"myInit"}]}`,
 		},
+		{
+			name: "named-runners",
+			report: Report{
+				Runners: []RunnerInfo{
+					{Type: "myRunner", Name: "public"},
+					{Type: "myRunner", Name: "admin"},
+				},
+			},
+			expectedJson: `{"configs":null,"deps":null,"runners":[{"type":"myRunner","name":"public"},{"type":"myRunner","name":"admin"}],"initializers":[]}`,
+		},
 	}
 
 	for _, tt := range tests {
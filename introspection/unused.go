@@ -0,0 +1,51 @@
+package introspection
+
+// depKey identifies a registered dependency by type, name, and implementation.
+type depKey struct {
+	typ, name, impl string
+}
+
+// UnusedDependencies returns the registered dependencies that were never resolved, in registration order.
+// A registration counts as used when a resolve event has the same type, name, and implementation,
+// so named and unnamed registrations of a type are checked separately, and an implementation that
+// was overwritten before anything resolved it is reported as unused. Repeated registrations of the
+// same dependency are reported once, by their first event.
+func UnusedDependencies(r Report) []DepEvent {
+	resolved := make(map[depKey]bool)
+	for _, ev := range r.Deps {
+		if ev.Kind == DepResolved {
+			resolved[depKey{ev.Type, ev.Name, ev.Impl}] = true
+		}
+	}
+
+	var unused []DepEvent
+	seen := make(map[depKey]bool)
+	for _, ev := range r.Deps {
+		key := depKey{ev.Type, ev.Name, ev.Impl}
+		if ev.Kind != DepRegistered || resolved[key] || seen[key] {
+			continue
+		}
+		seen[key] = true
+		unused = append(unused, ev)
+	}
+	return unused
+}
+
+// UnusedConfigKeys returns the declared configuration keys that were never read, in declaration order.
+// A declaration counts as used when any config access has the same key, so a key loaded by one component
+// is not reported for another. Such keys usually belong to stale config tags, or to components whose
+// wiring never ran because startup failed first.
+func UnusedConfigKeys(r Report) []ConfigDeclaration {
+	accessed := make(map[string]bool, len(r.Configs))
+	for _, c := range r.Configs {
+		accessed[c.Key] = true
+	}
+
+	var unused []ConfigDeclaration
+	for _, d := range r.DeclaredConfigs {
+		if !accessed[d.Key] {
+			unused = append(unused, d)
+		}
+	}
+	return unused
+}
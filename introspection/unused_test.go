@@ -0,0 +1,89 @@
+package introspection
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnusedDependencies(t *testing.T) {
+	db := DepEvent{Kind: DepRegistered, Type: "*sql.DB", Impl: "*sql.DB"}
+	primary := DepEvent{Kind: DepRegistered, Type: "*sql.DB", Name: "primary", Impl: "*sql.DB"}
+	memRepo := DepEvent{Kind: DepRegistered, Type: "store.Repo", Impl: "*store.Memory"}
+	pgRepo := DepEvent{Kind: DepRegistered, Type: "store.Repo", Impl: "*store.Postgres"}
+	resolved := func(ev DepEvent) DepEvent {
+		ev.Kind = DepResolved
+		return ev
+	}
+
+	tests := map[string]struct {
+		deps []DepEvent
+		want []DepEvent
+	}{
+		"no-dependencies": {},
+		"all-resolved": {
+			deps: []DepEvent{db, resolved(db)},
+		},
+		"named-and-unnamed-are-distinct": {
+			deps: []DepEvent{db, primary, resolved(primary)},
+			want: []DepEvent{db},
+		},
+		"overwritten-implementation-is-unused": {
+			deps: []DepEvent{memRepo, pgRepo, resolved(pgRepo)},
+			want: []DepEvent{memRepo},
+		},
+		"repeated-registration-reported-once": {
+			deps: []DepEvent{db, primary, db},
+			want: []DepEvent{db, primary},
+		},
+		"resolve-without-registration-is-ignored": {
+			deps: []DepEvent{resolved(db)},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := UnusedDependencies(Report{Deps: tt.deps})
+			if !reflect.DeepEqual(tt.want, got) {
+				t.Fatalf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestUnusedConfigKeys(t *testing.T) {
+	port := ConfigDeclaration{Key: "PORT", Field: "Port", Component: "*api.Server"}
+	adminPort := ConfigDeclaration{Key: "ADMIN_PORT", Field: "Port", Component: "*api.Server"}
+	legacy := ConfigDeclaration{Key: "LEGACY_MODE", Field: "Legacy", Component: "*worker.Worker"}
+
+	tests := map[string]struct {
+		declared []ConfigDeclaration
+		configs  []ConfigAccess
+		want     []ConfigDeclaration
+	}{
+		"no-declarations": {
+			configs: []ConfigAccess{{Key: "PORT"}},
+		},
+		"all-accessed": {
+			declared: []ConfigDeclaration{port, legacy},
+			configs:  []ConfigAccess{{Key: "LEGACY_MODE", UsedDefault: true}, {Key: "PORT"}},
+		},
+		"never-accessed-in-declaration-order": {
+			declared: []ConfigDeclaration{legacy, port, adminPort},
+			configs:  []ConfigAccess{{Key: "PORT"}},
+			want:     []ConfigDeclaration{legacy, adminPort},
+		},
+		"access-by-another-component-counts": {
+			declared: []ConfigDeclaration{port},
+			configs:  []ConfigAccess{{Key: "PORT", Component: "*admin.Server"}},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := UnusedConfigKeys(Report{Configs: tt.configs, DeclaredConfigs: tt.declared})
+			if !reflect.DeepEqual(tt.want, got) {
+				t.Fatalf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package symbiont
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+)
+
+// Logger receives structured lifecycle logs from the App.
+// Arguments after the message are alternating key-value pairs; *slog.Logger satisfies this interface.
+type Logger interface {
+	Debug(msg string, keysAndValues ...any)
+	Info(msg string, keysAndValues ...any)
+	Warn(msg string, keysAndValues ...any)
+	Error(msg string, keysAndValues ...any)
+}
+
+// noopLogger discards all logs. It is the default to keep the framework quiet.
+type noopLogger struct{}
+
+func (noopLogger) Debug(string, ...any) {}
+func (noopLogger) Info(string, ...any)  {}
+func (noopLogger) Warn(string, ...any)  {}
+func (noopLogger) Error(string, ...any) {}
+
+// WithLogger sets the logger used to report initializer, runnable, and shutdown lifecycle events (fluent method).
+// A nil logger is ignored; by default nothing is logged.
+func (a *App) WithLogger(l Logger) *App {
+	if l == nil {
+		return a
+	}
+	a.logger = l
+	return a
+}
+
+// appLogger returns the logger set with WithLogger, or a noopLogger for an App not created by NewApp.
+func (a *App) appLogger() Logger {
+	if a.logger == nil {
+		return noopLogger{}
+	}
+	return a.logger
+}
+
+// loggerKey is the context key under which LoggerFromContext finds a logger.
+type loggerKey struct{}
+
+// componentLogger is the logger the App puts in a component's context.
+// It keeps the logger it was derived from, so enrichment does not stack across components.
+type componentLogger struct {
+	Logger
+	base Logger
+}
+
+// fieldLogger prepends fixed key-value pairs to every log call of the wrapped logger.
+type fieldLogger struct {
+	logger Logger
+	fields []any
+}
+
+func (l fieldLogger) Debug(msg string, kv ...any) { l.logger.Debug(msg, l.with(kv)...) }
+func (l fieldLogger) Info(msg string, kv ...any)  { l.logger.Info(msg, l.with(kv)...) }
+func (l fieldLogger) Warn(msg string, kv ...any)  { l.logger.Warn(msg, l.with(kv)...) }
+func (l fieldLogger) Error(msg string, kv ...any) { l.logger.Error(msg, l.with(kv)...) }
+
+func (l fieldLogger) with(kv []any) []any {
+	return append(slices.Clone(l.fields), kv...)
+}
+
+// ContextWithLogger returns a copy of ctx carrying l, for example a request logger with a trace ID.
+// Passed to Run or RunWithContext, it becomes the logger that components are given.
+// A nil logger is ignored.
+func ContextWithLogger(ctx context.Context, l Logger) context.Context {
+	if l == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, loggerKey{}, l)
+}
+
+// LoggerFromContext returns the logger carried by ctx.
+// The contexts passed to Initialize and Run carry a logger with a "component" field naming the component.
+// When ctx carries no logger, slog.Default() is returned.
+func LoggerFromContext(ctx context.Context) Logger {
+	switch l := ctx.Value(loggerKey{}).(type) {
+	case componentLogger:
+		return l.Logger
+	case Logger:
+		return l
+	}
+	return slog.Default()
+}
+
+// LoggerWith returns a logger that adds the given key-value pairs to every log call.
+// A *slog.Logger is enriched with its own With method.
+func LoggerWith(l Logger, keysAndValues ...any) Logger {
+	if sl, ok := l.(*slog.Logger); ok {
+		return sl.With(keysAndValues...)
+	}
+	if len(keysAndValues) == 0 {
+		return l
+	}
+	return fieldLogger{logger: l, fields: keysAndValues}
+}
+
+// componentContext returns a copy of ctx carrying a logger enriched with the component name.
+// The logger is derived from the one in ctx, else the App logger when set, else slog.Default().
+func (a *App) componentContext(ctx context.Context, component any) context.Context {
+	var base Logger
+	switch l := ctx.Value(loggerKey{}).(type) {
+	case componentLogger:
+		base = l.base
+	case Logger:
+		base = l
+	default:
+		base = a.appLogger()
+		if _, ok := base.(noopLogger); ok {
+			base = slog.Default()
+		}
+	}
+	return context.WithValue(ctx, loggerKey{}, componentLogger{
+		Logger: LoggerWith(base, "component", componentName(component)),
+		base:   base,
+	})
+}
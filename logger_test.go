@@ -0,0 +1,230 @@
+package symbiont
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont/config"
+	"github.com/cleitonmarx/symbiont/depend"
+)
+
+// recordingLogger stores "LEVEL msg" entries for assertions.
+type recordingLogger struct {
+	mu      sync.Mutex
+	entries []string
+}
+
+func (r *recordingLogger) record(level, msg string, kv ...any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = append(r.entries, fmt.Sprintf("%s %s %v", level, msg, kv))
+}
+
+func (r *recordingLogger) Debug(msg string, kv ...any) { r.record("DEBUG", msg, kv...) }
+func (r *recordingLogger) Info(msg string, kv ...any)  { r.record("INFO", msg, kv...) }
+func (r *recordingLogger) Warn(msg string, kv ...any)  { r.record("WARN", msg, kv...) }
+func (r *recordingLogger) Error(msg string, kv ...any) { r.record("ERROR", msg, kv...) }
+
+func (r *recordingLogger) messages() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := make([]string, 0, len(r.entries))
+	for _, e := range r.entries {
+		level, rest, _ := strings.Cut(e, " ")
+		msg, _, _ := strings.Cut(rest, " [")
+		out = append(out, level+" "+msg)
+	}
+	return out
+}
+
+func TestApp_WithLogger(t *testing.T) {
+	tests := map[string]struct {
+		inits        []Initializer
+		runs         []Runnable
+		wantMessages []string
+	}{
+		"successful-lifecycle": {
+			inits: []Initializer{&ctxInitializer{key: testContextKey, val: "v"}},
+			runs:  []Runnable{&runCloser{log: &[]string{}}},
+			wantMessages: []string{
+				"DEBUG initializer started",
+				"INFO initializer finished",
+				"INFO runnable started",
+				"INFO runnable stopped",
+				"INFO shutdown started",
+				"INFO shutdown completed",
+			},
+		},
+		"initializer-error": {
+			inits: []Initializer{&errInitializer{}},
+			wantMessages: []string{
+				"DEBUG initializer started",
+				"ERROR initializer failed",
+				"INFO shutdown started",
+				"INFO shutdown completed",
+			},
+		},
+		"runnable-error": {
+			runs: []Runnable{&runCloser{log: &[]string{}, willErr: true}},
+			wantMessages: []string{
+				"INFO runnable started",
+				"ERROR runnable failed",
+				"INFO shutdown started",
+				"INFO shutdown completed",
+			},
+		},
+		"wiring-error": {
+			runs: []Runnable{&resolveDepRun{}},
+			wantMessages: []string{
+				"ERROR runnable wiring failed",
+				"INFO shutdown started",
+				"INFO shutdown completed",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			depend.ClearContainer()
+			config.ResetGlobalProvider()
+			defer func() { depend.ClearContainer(); config.ResetGlobalProvider() }()
+
+			logger := &recordingLogger{}
+			_ = NewApp().
+				WithLogger(logger).
+				Initialize(tt.inits...).
+				Host(tt.runs...).
+				RunWithContext(context.Background())
+
+			if got := logger.messages(); !slices.Equal(tt.wantMessages, got) {
+				t.Fatalf("expected messages %v, got %v", tt.wantMessages, got)
+			}
+		})
+	}
+}
+
+func TestApp_WithLogger_Defaults(t *testing.T) {
+	a := NewApp()
+	if _, ok := a.logger.(noopLogger); !ok {
+		t.Fatalf("expected noop logger by default, got %T", a.logger)
+	}
+	a.WithLogger(nil)
+	if _, ok := a.logger.(noopLogger); !ok {
+		t.Fatalf("expected nil logger to be ignored, got %T", a.logger)
+	}
+	a.WithLogger(slog.Default())
+	if _, ok := a.logger.(*slog.Logger); !ok {
+		t.Fatalf("expected slog logger, got %T", a.logger)
+	}
+}
+
+// loggingInitializer logs through the logger in its context and returns a derived context.
+type loggingInitializer struct{}
+
+func (loggingInitializer) Initialize(ctx context.Context) (context.Context, error) {
+	LoggerFromContext(ctx).Info("init hello")
+	return context.WithValue(ctx, testContextKey, "v"), nil
+}
+
+// loggingRunnable logs through the logger in its context.
+type loggingRunnable struct{}
+
+func (*loggingRunnable) Run(ctx context.Context) error {
+	LoggerFromContext(ctx).Info("run hello", "n", 1)
+	return nil
+}
+
+func TestLoggerFromContext(t *testing.T) {
+	if got := LoggerFromContext(context.Background()); got != slog.Default() {
+		t.Fatalf("expected slog.Default() without a logger, got %v", got)
+	}
+	if got := LoggerFromContext(ContextWithLogger(context.Background(), nil)); got != slog.Default() {
+		t.Fatalf("expected nil logger to be ignored, got %v", got)
+	}
+	logger := &recordingLogger{}
+	if got := LoggerFromContext(ContextWithLogger(context.Background(), logger)); got != logger {
+		t.Fatalf("expected context logger, got %v", got)
+	}
+}
+
+func TestLoggerWith(t *testing.T) {
+	logger := &recordingLogger{}
+	LoggerWith(LoggerWith(logger, "a", 1), "b", 2).Warn("msg", "c", 3)
+	if want := []string{"WARN msg [a 1 b 2 c 3]"}; !slices.Equal(want, logger.entries) {
+		t.Fatalf("expected %v, got %v", want, logger.entries)
+	}
+	if got := LoggerWith(logger); got != Logger(logger) {
+		t.Fatalf("expected logger without fields to be returned unchanged, got %v", got)
+	}
+	if _, ok := LoggerWith(slog.Default(), "a", 1).(*slog.Logger); !ok {
+		t.Fatal("expected slog logger to stay a *slog.Logger")
+	}
+}
+
+func TestApp_ComponentLogger(t *testing.T) {
+	tests := map[string]struct {
+		appLogger bool
+		ctxLogger bool
+	}{
+		"app-logger":                   {appLogger: true},
+		"context-logger":               {ctxLogger: true},
+		"context-logger-wins-over-app": {appLogger: true, ctxLogger: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			depend.ClearContainer()
+			config.ResetGlobalProvider()
+			defer func() { depend.ClearContainer(); config.ResetGlobalProvider() }()
+
+			appLogger, ctxLogger := &recordingLogger{}, &recordingLogger{}
+			app := NewApp().Initialize(&loggingInitializer{}).Host(&loggingRunnable{})
+			if tt.appLogger {
+				app.WithLogger(appLogger)
+			}
+			ctx := context.Background()
+			if tt.ctxLogger {
+				ctx = ContextWithLogger(ctx, ctxLogger)
+			}
+			if err := app.RunWithContext(ctx); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			got := ctxLogger
+			if !tt.ctxLogger {
+				got = appLogger
+			}
+			var entries []string
+			for _, e := range got.entries {
+				if strings.Contains(e, " hello ") {
+					entries = append(entries, e)
+				}
+			}
+			want := []string{
+				"INFO init hello [component *symbiont.loggingInitializer]",
+				"INFO run hello [component *symbiont.loggingRunnable n 1]",
+			}
+			if !slices.Equal(want, entries) {
+				t.Fatalf("expected component logs %v, got %v", want, entries)
+			}
+		})
+	}
+}
+
+func TestApp_ZeroValueLogger(t *testing.T) {
+	log := []string{}
+	a := &App{}
+	err := a.Host(&runCloser{name: "r", log: &log}, &runCloser{name: "failing", log: &log, willErr: true}).
+		RunWithContext(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "run error") {
+		t.Fatalf("expected the runnable error, got %v", err)
+	}
+	if len(log) != 2 {
+		t.Fatalf("expected both closers to run, got %v", log)
+	}
+}
@@ -0,0 +1,274 @@
+package symbiont
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cleitonmarx/symbiont/introspection"
+)
+
+// MetricsCollector contributes custom metrics to each MetricsServer scrape.
+// Implementations write samples in the Prometheus text exposition format.
+type MetricsCollector interface {
+	Collect(w io.Writer) error
+}
+
+// MetricsCollectorFunc adapts a function to the MetricsCollector interface.
+type MetricsCollectorFunc func(w io.Writer) error
+
+// Collect calls f(w).
+func (f MetricsCollectorFunc) Collect(w io.Writer) error {
+	return f(w)
+}
+
+// lifecycleObserver is notified by the App about runnable and shutdown lifecycle events.
+// Hosted runnables implementing it are discovered automatically.
+type lifecycleObserver interface {
+	runnableStarted(runnable string)
+	runnableStopped(runnable string, exit introspection.RunnableExitEvent, err error)
+	runnableRestarted(runnable string)
+	shutdownCompleted(d time.Duration)
+}
+
+// MetricsServer is a Runnable that serves application lifecycle metrics at /metrics
+// using the Prometheus text exposition format, without depending on a Prometheus client library.
+// Component inventory gauges are sourced from the introspection report; lifecycle counters are
+// fed by the App while the server is hosted, labeled with the runnable's type and, for runnables
+// implementing Named, its instance name.
+//
+// The last shutdown duration is only known once the App's closers have run, after the hosted
+// server has stopped listening. It can be scraped through Handler mounted on a server that outlives
+// the App's Run, or after the App is run again.
+type MetricsServer struct {
+	addr string
+
+	mu               sync.Mutex
+	collectors       []MetricsCollector
+	report           introspection.Report
+	started          map[string]uint64
+	stopped          map[string]uint64
+	failed           map[string]uint64
+	restarts         map[string]uint64
+	exits            map[runnableExitKey]uint64
+	lastRunDuration  map[string]time.Duration
+	shutdownDuration time.Duration
+	listenAddr       string
+	running          bool
+}
+
+// MetricsRunnable creates a MetricsServer listening on addr once hosted.
+func MetricsRunnable(addr string) *MetricsServer {
+	return &MetricsServer{
+		addr:            addr,
+		started:         make(map[string]uint64),
+		stopped:         make(map[string]uint64),
+		failed:          make(map[string]uint64),
+		restarts:        make(map[string]uint64),
+		exits:           make(map[runnableExitKey]uint64),
+		lastRunDuration: make(map[string]time.Duration),
+	}
+}
+
+// RegisterCollector adds a custom collector to every scrape.
+// Collectors must be registered before the server starts running.
+func (m *MetricsServer) RegisterCollector(c MetricsCollector) error {
+	if c == nil {
+		return errors.New("metrics: collector must not be nil")
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.running {
+		return errors.New("metrics: collectors must be registered before Run")
+	}
+	m.collectors = append(m.collectors, c)
+	return nil
+}
+
+// Run listens on the configured address and serves metrics until the context is canceled.
+func (m *MetricsServer) Run(ctx context.Context) error {
+	m.mu.Lock()
+	if m.running {
+		m.mu.Unlock()
+		return errors.New("metrics: server is already running")
+	}
+	m.running = true
+	m.mu.Unlock()
+	defer func() {
+		m.mu.Lock()
+		m.running = false
+		m.listenAddr = ""
+		m.mu.Unlock()
+	}()
+
+	listener, err := net.Listen("tcp", m.addr)
+	if err != nil {
+		return fmt.Errorf("metrics: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", m.Handler())
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+	m.mu.Lock()
+	m.listenAddr = listener.Addr().String()
+	m.mu.Unlock()
+
+	if err := serveUntilDone(ctx, srv, listener); err != nil {
+		return fmt.Errorf("metrics: %w", err)
+	}
+	return nil
+}
+
+// IsReady reports whether the metrics server is listening.
+func (m *MetricsServer) IsReady(context.Context) error {
+	if m.Addr() == "" {
+		return errors.New("metrics: server is not listening")
+	}
+	return nil
+}
+
+// Addr returns the address the server is listening on, or an empty string when it is not running.
+func (m *MetricsServer) Addr() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.listenAddr
+}
+
+// Introspect captures the introspection report used for component inventory gauges.
+func (m *MetricsServer) Introspect(_ context.Context, r introspection.Report) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.report = r
+	return nil
+}
+
+// Handler returns an http.Handler that writes the current metrics.
+// It can be mounted on an existing mux instead of hosting the MetricsServer.
+func (m *MetricsServer) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		var b strings.Builder
+		if err := m.writeMetrics(&b); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		_, _ = io.WriteString(w, b.String())
+	})
+}
+
+// writeMetrics renders built-in and custom metrics in the Prometheus text exposition format.
+func (m *MetricsServer) writeMetrics(w io.Writer) error {
+	m.mu.Lock()
+	writeCounterVec(w, "symbiont_runnable_starts_total", "Number of times a runnable started.", m.started)
+	writeCounterVec(w, "symbiont_runnable_stops_total", "Number of times a runnable returned.", m.stopped)
+	writeCounterVec(w, "symbiont_runnable_failures_total", "Number of times a runnable returned an error.", m.failed)
+	writeCounterVec(w, "symbiont_runnable_restarts_total", "Number of times a runnable was restarted.", m.restarts)
+	writeExitCounter(w, "symbiont_runnable_exits_total", "Number of times a runnable returned, by exit reason.", m.exits)
+	writeDurationGaugeVec(w, "symbiont_runnable_last_run_duration_seconds", "Duration of the last run of a runnable.", m.lastRunDuration)
+	writeGauge(w, "symbiont_last_shutdown_duration_seconds", "Duration of the last completed shutdown.", m.shutdownDuration.Seconds())
+	writeGauge(w, "symbiont_runnables", "Number of hosted runnables.", float64(len(m.report.Runners)))
+	writeGauge(w, "symbiont_initializers", "Number of registered initializers.", float64(len(m.report.Initializers)))
+	writeGauge(w, "symbiont_dependency_events", "Number of recorded dependency events.", float64(len(m.report.Deps)))
+	writeGauge(w, "symbiont_config_accesses", "Number of recorded configuration accesses.", float64(len(m.report.Configs)))
+	collectors := append([]MetricsCollector(nil), m.collectors...)
+	m.mu.Unlock()
+
+	for _, c := range collectors {
+		if err := c.Collect(w); err != nil {
+			return fmt.Errorf("metrics: %w", err)
+		}
+	}
+	return nil
+}
+
+func (m *MetricsServer) runnableStarted(runnable string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.started[runnable]++
+}
+
+func (m *MetricsServer) runnableStopped(runnable string, exit introspection.RunnableExitEvent, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stopped[runnable]++
+	if err != nil {
+		m.failed[runnable]++
+	}
+	m.exits[runnableExitKey{runnable: runnable, reason: exit.Reason}]++
+	m.lastRunDuration[runnable] = exit.Duration
+}
+
+func (m *MetricsServer) runnableRestarted(runnable string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.restarts[runnable]++
+}
+
+func (m *MetricsServer) shutdownCompleted(d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.shutdownDuration = d
+}
+
+// writeCounterVec writes a counter with one sample per runnable label, sorted for stable output.
+func writeCounterVec(w io.Writer, name, help string, values map[string]uint64) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s counter\n", name, help, name)
+	labels := make([]string, 0, len(values))
+	for label := range values {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+	for _, label := range labels {
+		fmt.Fprintf(w, "%s{runnable=%q} %d\n", name, label, values[label])
+	}
+}
+
+// runnableExitKey labels the exit counter of a runnable.
+type runnableExitKey struct {
+	runnable string
+	reason   introspection.RunnableExitReason
+}
+
+// writeExitCounter writes a counter with one sample per runnable and exit reason, sorted for stable output.
+func writeExitCounter(w io.Writer, name, help string, values map[runnableExitKey]uint64) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s counter\n", name, help, name)
+	keys := make([]runnableExitKey, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].runnable == keys[j].runnable {
+			return keys[i].reason < keys[j].reason
+		}
+		return keys[i].runnable < keys[j].runnable
+	})
+	for _, key := range keys {
+		fmt.Fprintf(w, "%s{runnable=%q,reason=%q} %d\n", name, key.runnable, key.reason, values[key])
+	}
+}
+
+// writeDurationGaugeVec writes a gauge in seconds with one sample per runnable label, sorted for stable output.
+func writeDurationGaugeVec(w io.Writer, name, help string, values map[string]time.Duration) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s gauge\n", name, help, name)
+	labels := make([]string, 0, len(values))
+	for label := range values {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+	for _, label := range labels {
+		fmt.Fprintf(w, "%s{runnable=%q} %g\n", name, label, values[label].Seconds())
+	}
+}
+
+// writeGauge writes a single unlabeled gauge sample.
+func writeGauge(w io.Writer, name, help string, value float64) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s gauge\n%s %g\n", name, help, name, name, value)
+}
@@ -0,0 +1,185 @@
+package symbiont
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont/config"
+	"github.com/cleitonmarx/symbiont/depend"
+	"github.com/cleitonmarx/symbiont/introspection"
+)
+
+func TestMetricsRunnable(t *testing.T) {
+	depend.ClearContainer()
+	config.ResetGlobalProvider()
+	defer func() { depend.ClearContainer(); config.ResetGlobalProvider() }()
+
+	metrics := MetricsRunnable("127.0.0.1:0")
+	err := metrics.RegisterCollector(MetricsCollectorFunc(func(w io.Writer) error {
+		_, err := fmt.Fprintln(w, "custom_metric 7")
+		return err
+	}))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	app := NewApp().
+		Initialize(&recCloser{name: "init", log: &[]string{}}).
+		Host(metrics, &waitRunnable{done: make(chan struct{})})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errCh := app.RunAsync(ctx)
+	if err := app.WaitForReadiness(ctx, time.Second); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if err := metrics.RegisterCollector(MetricsCollectorFunc(func(io.Writer) error { return nil })); err == nil {
+		t.Fatal("expected error registering collector after Run")
+	}
+
+	resp, err := http.Get("http://" + metrics.Addr() + "/metrics")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	for _, want := range []string{
+		`symbiont_runnable_starts_total{runnable="*symbiont.waitRunnable"} 1`,
+		`symbiont_runnable_starts_total{runnable="*symbiont.MetricsServer"} 1`,
+		"# TYPE symbiont_runnable_restarts_total counter",
+		"symbiont_runnables 2",
+		"symbiont_initializers 1",
+		"custom_metric 7",
+	} {
+		if !strings.Contains(string(body), want) {
+			t.Fatalf("expected metrics to contain %q, got:\n%s", want, body)
+		}
+	}
+
+	cancel()
+	select {
+	case err := <-errCh:
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("app did not stop after context cancel")
+	}
+	if err := metrics.IsReady(context.Background()); err == nil || metrics.Addr() != "" {
+		t.Fatalf("expected server not to be listening after shutdown, got %v at %q", err, metrics.Addr())
+	}
+
+	var b strings.Builder
+	if err := metrics.writeMetrics(&b); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	for _, want := range []string{
+		`symbiont_runnable_stops_total{runnable="*symbiont.waitRunnable"} 1`,
+		`symbiont_runnable_exits_total{runnable="*symbiont.waitRunnable",reason="canceled"} 1`,
+		`symbiont_runnable_last_run_duration_seconds{runnable="*symbiont.waitRunnable"} `,
+	} {
+		if !strings.Contains(b.String(), want) {
+			t.Fatalf("expected metrics to contain %q after shutdown, got:\n%s", want, b.String())
+		}
+	}
+}
+
+func TestMetricsServer_HandlerAfterShutdown(t *testing.T) {
+	depend.ClearContainer()
+	config.ResetGlobalProvider()
+	defer func() { depend.ClearContainer(); config.ResetGlobalProvider() }()
+
+	metrics := MetricsRunnable("127.0.0.1:0")
+	// The handler is mounted on a server that outlives the App, so the shutdown gauge can be scraped.
+	external := httptest.NewServer(metrics.Handler())
+	defer external.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	app := NewApp().Host(metrics, &namedRunnable{name: "a"}, &namedRunnable{name: "b"}, &waitRunnable{done: make(chan struct{})})
+	errCh := app.RunAsync(ctx)
+	if err := app.WaitForReadiness(ctx, time.Second); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	cancel()
+	if err := <-errCh; err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	resp, err := http.Get(external.URL)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	for _, want := range []string{
+		`symbiont_runnable_starts_total{runnable="*symbiont.namedRunnable[a]"} 1`,
+		`symbiont_runnable_starts_total{runnable="*symbiont.namedRunnable[b]"} 1`,
+		"symbiont_last_shutdown_duration_seconds ",
+	} {
+		if !strings.Contains(string(body), want) {
+			t.Fatalf("expected metrics to contain %q, got:\n%s", want, body)
+		}
+	}
+	if strings.Contains(string(body), "symbiont_last_shutdown_duration_seconds 0\n") {
+		t.Fatalf("expected the last shutdown duration to be recorded, got:\n%s", body)
+	}
+}
+
+func TestMetricsServer_Handler(t *testing.T) {
+	tests := map[string]struct {
+		collector  MetricsCollector
+		wantStatus int
+		wantBody   string
+	}{
+		"built-in-metrics": {
+			wantStatus: http.StatusOK,
+			wantBody:   "# TYPE symbiont_last_shutdown_duration_seconds gauge",
+		},
+		"exit-reasons": {
+			wantStatus: http.StatusOK,
+			wantBody:   `symbiont_runnable_exits_total{runnable="worker",reason="error"} 1`,
+		},
+		"restarts": {
+			wantStatus: http.StatusOK,
+			wantBody:   `symbiont_runnable_restarts_total{runnable="worker"} 1`,
+		},
+		"collector-error": {
+			collector:  MetricsCollectorFunc(func(io.Writer) error { return errors.New("collect failed") }),
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   "metrics: collect failed",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := MetricsRunnable("")
+			if tt.collector != nil {
+				if err := m.RegisterCollector(tt.collector); err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+			}
+			m.runnableStopped("worker", introspection.RunnableExitEvent{Reason: introspection.ExitError}, errors.New("boom"))
+			m.runnableRestarted("worker")
+
+			rec := httptest.NewRecorder()
+			m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, rec.Code)
+			}
+			if !strings.Contains(rec.Body.String(), tt.wantBody) {
+				t.Fatalf("expected body to contain %q, got %q", tt.wantBody, rec.Body.String())
+			}
+		})
+	}
+}
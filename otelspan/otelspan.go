@@ -0,0 +1,42 @@
+// Package otelspan adapts an OpenTelemetry tracer to symbiont.Tracer.
+// It lives in its own package so the core framework does not depend on OpenTelemetry.
+package otelspan
+
+import (
+	"context"
+
+	"github.com/cleitonmarx/symbiont"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracer records symbiont lifecycle spans with an OpenTelemetry tracer.
+type Tracer struct {
+	tracer trace.Tracer
+}
+
+// NewTracer returns a symbiont.Tracer that starts spans with t:
+//
+//	app.WithTracer(otelspan.NewTracer(otel.Tracer("symbiont")))
+func NewTracer(t trace.Tracer) *Tracer {
+	return &Tracer{tracer: t}
+}
+
+// Start begins an OpenTelemetry span as a child of any span in ctx and returns a copy of ctx carrying it.
+func (t *Tracer) Start(ctx context.Context, spanName string) (context.Context, symbiont.Span) {
+	ctx, span := t.tracer.Start(ctx, spanName)
+	return ctx, spanAdapter{span: span}
+}
+
+// spanAdapter ends an OpenTelemetry span, marking it as failed when the phase returned an error.
+type spanAdapter struct {
+	span trace.Span
+}
+
+func (s spanAdapter) End(err error) {
+	if err != nil {
+		s.span.RecordError(err)
+		s.span.SetStatus(codes.Error, err.Error())
+	}
+	s.span.End()
+}
@@ -0,0 +1,75 @@
+package otelspan
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/embedded"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// fakeTracer records the spans it starts.
+type fakeTracer struct {
+	embedded.Tracer
+	spans []*fakeSpan
+}
+
+func (f *fakeTracer) Start(ctx context.Context, name string, _ ...trace.SpanStartOption) (context.Context, trace.Span) {
+	span := &fakeSpan{name: name}
+	f.spans = append(f.spans, span)
+	return trace.ContextWithSpan(ctx, span), span
+}
+
+type fakeSpan struct {
+	noop.Span
+	name   string
+	ended  bool
+	status codes.Code
+	errs   []error
+}
+
+func (s *fakeSpan) End(...trace.SpanEndOption)                    { s.ended = true }
+func (s *fakeSpan) RecordError(err error, _ ...trace.EventOption) { s.errs = append(s.errs, err) }
+func (s *fakeSpan) SetStatus(code codes.Code, _ string)           { s.status = code }
+
+func TestTracer(t *testing.T) {
+	tests := map[string]struct {
+		err        error
+		wantStatus codes.Code
+		wantErrs   int
+	}{
+		"success": {
+			wantStatus: codes.Unset,
+		},
+		"failure-marks-span-as-error": {
+			err:        errors.New("boom"),
+			wantStatus: codes.Error,
+			wantErrs:   1,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			fake := &fakeTracer{}
+			ctx, started := NewTracer(fake).Start(context.Background(), "Start *app.Server")
+			started.End(tt.err)
+
+			if len(fake.spans) != 1 {
+				t.Fatalf("expected 1 span, got %d", len(fake.spans))
+			}
+			span := fake.spans[0]
+			if span.name != "Start *app.Server" || !span.ended {
+				t.Fatalf("expected ended span %q, got %q (ended: %v)", "Start *app.Server", span.name, span.ended)
+			}
+			if trace.SpanFromContext(ctx) != span {
+				t.Fatal("expected the returned context to carry the span")
+			}
+			if span.status != tt.wantStatus || len(span.errs) != tt.wantErrs {
+				t.Fatalf("expected status %v with %d errors, got %v with %d", tt.wantStatus, tt.wantErrs, span.status, len(span.errs))
+			}
+		})
+	}
+}
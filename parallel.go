@@ -0,0 +1,124 @@
+package symbiont
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ParallelGroup is a Runnable that runs other runnables concurrently, at most limit at a time.
+type ParallelGroup struct {
+	limit      int
+	runnables  []Runnable
+	waitForAll bool
+}
+
+// Parallel returns a ParallelGroup that runs the runnables concurrently, with at most limit of them
+// running at any time, as a single hosted unit. It is meant for a batch of bounded workers, such as
+// CPU-bound one-shots, where Host would start them all at once. A limit <= 0 runs all of them at once.
+// Nil runnables are ignored.
+//
+//	app := symbiont.NewApp().
+//		Host(symbiont.Parallel(runtime.NumCPU(), jobs...))
+//
+// By default Run returns as soon as a runnable fails: the context of the other runnables is
+// canceled, no further runnable is started, and the first error is returned once the started ones
+// return. Once the context passed to Run is canceled, no further runnable is started.
+//
+// Like Sequence, the runnables are wired and their closers registered by the app, while
+// introspection reports the group as one runnable named after them.
+func Parallel(limit int, runnables ...Runnable) *ParallelGroup {
+	group := &ParallelGroup{limit: limit}
+	for _, r := range runnables {
+		if r != nil {
+			group.runnables = append(group.runnables, r)
+		}
+	}
+	return group
+}
+
+// WaitForAll makes Run let every runnable complete even when some fail, returning their errors
+// joined in the order the runnables were given.
+func (p *ParallelGroup) WaitForAll(enabled bool) *ParallelGroup {
+	p.waitForAll = enabled
+	return p
+}
+
+// Run starts the runnables as slots free up and returns when all started runnables have returned.
+func (p *ParallelGroup) Run(ctx context.Context) error {
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	limit := p.limit
+	if limit <= 0 {
+		limit = len(p.runnables)
+	}
+	slots := make(chan struct{}, max(limit, 1))
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	errs := make([]error, len(p.runnables))
+	for i, r := range p.runnables {
+		select {
+		case slots <- struct{}{}:
+		case <-runCtx.Done():
+		}
+		if runCtx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+			err := runParallelSafe(runCtx, r)
+			if err == nil {
+				return
+			}
+			err = fmt.Errorf("symbiont: parallel runnable %d (%s): %w", i+1, componentName(r), err)
+			errs[i] = err
+			mu.Lock()
+			defer mu.Unlock()
+			if firstErr == nil {
+				firstErr = err
+				if !p.waitForAll {
+					cancel()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if p.waitForAll {
+		return errors.Join(errs...)
+	}
+	return firstErr
+}
+
+// runParallelSafe runs r, turning a panic into an error, since a panic in a goroutine of the group
+// cannot be recovered by the app.
+func runParallelSafe(ctx context.Context, r Runnable) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("panic in Run func: %v", rec)
+		}
+	}()
+	return r.Run(ctx)
+}
+
+// Name lists the runnables, so introspection tells groups apart.
+func (p *ParallelGroup) Name() string {
+	names := make([]string, 0, len(p.runnables))
+	for _, r := range p.runnables {
+		names = append(names, componentName(r))
+	}
+	return strings.Join(names, " | ")
+}
+
+func (p *ParallelGroup) innerRunnables() []Runnable {
+	return p.runnables
+}
@@ -0,0 +1,184 @@
+package symbiont
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont/depend"
+)
+
+// concurrencyProbe records the highest number of its runnables running at once.
+type concurrencyProbe struct {
+	running atomic.Int32
+	peak    atomic.Int32
+}
+
+// runnable returns a runnable that holds a slot for d and then returns err.
+func (c *concurrencyProbe) runnable(d time.Duration, err error) Runnable {
+	return funcRunnable(func(ctx context.Context) error {
+		n := c.running.Add(1)
+		defer c.running.Add(-1)
+		for {
+			peak := c.peak.Load()
+			if n <= peak || c.peak.CompareAndSwap(peak, n) {
+				break
+			}
+		}
+		select {
+		case <-time.After(d):
+		case <-ctx.Done():
+		}
+		return err
+	})
+}
+
+func TestParallel_Limit(t *testing.T) {
+	tests := map[string]struct {
+		limit    int
+		count    int
+		wantPeak int32
+	}{
+		"limit-1-serializes": {limit: 1, count: 4, wantPeak: 1},
+		"limit-2":            {limit: 2, count: 4, wantPeak: 2},
+		"no-limit":           {limit: 0, count: 3, wantPeak: 3},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var probe concurrencyProbe
+			runnables := make([]Runnable, 0, tt.count)
+			for range tt.count {
+				runnables = append(runnables, probe.runnable(20*time.Millisecond, nil))
+			}
+
+			if err := Parallel(tt.limit, runnables...).Run(context.Background()); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got := probe.peak.Load(); got != tt.wantPeak {
+				t.Fatalf("expected at most %d runnables at once, got %d", tt.wantPeak, got)
+			}
+		})
+	}
+}
+
+func TestParallel_Errors(t *testing.T) {
+	boom := errors.New("boom")
+
+	tests := map[string]struct {
+		waitForAll  bool
+		runnables   func(started *[]int, mu *sync.Mutex) []Runnable
+		wantStarted []int
+		wantErr     string
+	}{
+		"first-error-stops-the-group": {
+			runnables: func(started *[]int, mu *sync.Mutex) []Runnable {
+				return []Runnable{
+					recordStart(started, mu, 1, boom),
+					recordStart(started, mu, 2, nil),
+				}
+			},
+			wantStarted: []int{1},
+			wantErr:     "symbiont: parallel runnable 1 (symbiont.funcRunnable): boom",
+		},
+		"wait-for-all-joins-errors": {
+			waitForAll: true,
+			runnables: func(started *[]int, mu *sync.Mutex) []Runnable {
+				return []Runnable{
+					recordStart(started, mu, 1, boom),
+					recordStart(started, mu, 2, nil),
+					recordStart(started, mu, 3, errors.New("bang")),
+				}
+			},
+			wantStarted: []int{1, 2, 3},
+			wantErr:     "symbiont: parallel runnable 1 (symbiont.funcRunnable): boom\nsymbiont: parallel runnable 3 (symbiont.funcRunnable): bang",
+		},
+		"panic-becomes-error": {
+			runnables: func(started *[]int, mu *sync.Mutex) []Runnable {
+				return []Runnable{funcRunnable(func(context.Context) error { panic("kaboom") })}
+			},
+			wantErr: "symbiont: parallel runnable 1 (symbiont.funcRunnable): panic in Run func: kaboom",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var (
+				mu      sync.Mutex
+				started []int
+			)
+			err := Parallel(1, tt.runnables(&started, &mu)...).WaitForAll(tt.waitForAll).Run(context.Background())
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+			}
+			if !slices.Equal(started, tt.wantStarted) {
+				t.Fatalf("expected started %v, got %v", tt.wantStarted, started)
+			}
+		})
+	}
+}
+
+// recordStart returns a runnable that appends id to started and returns err.
+func recordStart(started *[]int, mu *sync.Mutex, id int, err error) Runnable {
+	return funcRunnable(func(context.Context) error {
+		mu.Lock()
+		defer mu.Unlock()
+		*started = append(*started, id)
+		return err
+	})
+}
+
+func TestParallel_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var probe concurrencyProbe
+	var started atomic.Int32
+	first := funcRunnable(func(ctx context.Context) error {
+		started.Add(1)
+		cancel()
+		<-ctx.Done()
+		return nil
+	})
+
+	err := Parallel(1, first, probe.runnable(0, nil), probe.runnable(0, nil)).Run(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if started.Load() != 1 || probe.peak.Load() != 0 {
+		t.Fatalf("expected no runnable to start after cancellation, got %d more", probe.peak.Load())
+	}
+}
+
+func TestParallel_Name(t *testing.T) {
+	group := Parallel(2, &waitRunnable{}, nil, &stepRunnable{})
+	if got, want := group.Name(), "*symbiont.waitRunnable | *symbiont.stepRunnable"; got != want {
+		t.Fatalf("expected name %q, got %q", want, got)
+	}
+}
+
+func TestApp_HostParallel(t *testing.T) {
+	depend.ClearContainer()
+	defer depend.ClearContainer()
+
+	var got string
+	var closed []string
+	app := NewApp().
+		Initialize(&depRegisterInitializer{value: "hello"}).
+		Host(Parallel(1,
+			&resolveDepRun{gotVal: &got},
+			&runCloser{name: "inner", log: &closed},
+		))
+
+	if err := app.RunWithContext(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got != "hello" {
+		t.Fatalf("expected the runnable to be wired with %q, got %q", "hello", got)
+	}
+	if !slices.Equal(closed, []string{"inner"}) {
+		t.Fatalf("expected the inner runnable to be closed, got %v", closed)
+	}
+}
@@ -0,0 +1,98 @@
+package symbiont
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+)
+
+// PeriodicTask is a Runnable that calls a function on a fixed interval, replacing hand-written
+// ticker loops. An optional jitter spreads the calls of many instances so they do not all fire
+// at the same moment.
+type PeriodicTask struct {
+	interval    time.Duration
+	jitter      time.Duration
+	fn          func(ctx context.Context) error
+	stopOnError bool
+	name        string
+	// randDuration returns a random duration in [0, n); it is replaced in tests.
+	randDuration func(n time.Duration) time.Duration
+}
+
+// PeriodicRunnable creates a PeriodicTask that calls fn every interval, plus a random delay of up
+// to jitter chosen anew for each call. A jitter <= 0 keeps the calls on the exact interval.
+//
+//	app := symbiont.NewApp().
+//		Host(symbiont.PeriodicRunnable(time.Minute, 10*time.Second, purgeExpiredTodos))
+func PeriodicRunnable(interval, jitter time.Duration, fn func(ctx context.Context) error) *PeriodicTask {
+	return &PeriodicTask{
+		interval: interval,
+		jitter:   jitter,
+		fn:       fn,
+		randDuration: func(n time.Duration) time.Duration {
+			return rand.N(n)
+		},
+	}
+}
+
+// StopOnError makes Run return the first error returned by the function, which stops the app.
+// By default errors are logged to the context's logger and the task keeps running.
+func (p *PeriodicTask) StopOnError(enabled bool) *PeriodicTask {
+	p.stopOnError = enabled
+	return p
+}
+
+// WithName sets the instance name reported by introspection, so several periodic tasks can be
+// told apart.
+func (p *PeriodicTask) WithName(name string) *PeriodicTask {
+	p.name = name
+	return p
+}
+
+// Name returns the instance name set by WithName.
+func (p *PeriodicTask) Name() string {
+	return p.name
+}
+
+// Run calls the function on every tick until the context is canceled.
+// The first call happens one interval, plus jitter, after Run starts.
+func (p *PeriodicTask) Run(ctx context.Context) error {
+	if p.interval <= 0 {
+		return fmt.Errorf("symbiont: periodic task interval must be positive, got %s", p.interval)
+	}
+	if p.fn == nil {
+		return errors.New("symbiont: periodic task function must not be nil")
+	}
+
+	timer := time.NewTimer(p.nextDelay())
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-timer.C:
+		}
+
+		if err := p.fn(ctx); err != nil {
+			if ctx.Err() != nil {
+				// The call was interrupted by shutdown.
+				return nil
+			}
+			if p.stopOnError {
+				return fmt.Errorf("symbiont: periodic task: %w", err)
+			}
+			LoggerFromContext(ctx).Error("periodic task failed", "error", err)
+		}
+		timer.Reset(p.nextDelay())
+	}
+}
+
+// nextDelay returns the interval plus a random jitter.
+func (p *PeriodicTask) nextDelay() time.Duration {
+	if p.jitter <= 0 {
+		return p.interval
+	}
+	return p.interval + p.randDuration(p.jitter)
+}
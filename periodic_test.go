@@ -0,0 +1,151 @@
+package symbiont
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestPeriodicRunnable(t *testing.T) {
+	tests := map[string]struct {
+		interval    time.Duration
+		fn          func(calls int) error
+		stopOnError bool
+		nilFn       bool
+		wantCalls   int
+		wantErr     string
+		wantLogs    []string
+	}{
+		"calls-until-canceled": {
+			interval:  5 * time.Millisecond,
+			fn:        func(int) error { return nil },
+			wantCalls: 3,
+		},
+		"logs-errors-and-continues": {
+			interval: 5 * time.Millisecond,
+			fn: func(calls int) error {
+				if calls == 1 {
+					return errors.New("boom")
+				}
+				return nil
+			},
+			wantCalls: 3,
+			wantLogs:  []string{"ERROR periodic task failed [error boom]"},
+		},
+		"stops-on-first-error": {
+			interval: 5 * time.Millisecond,
+			fn: func(calls int) error {
+				if calls == 2 {
+					return errors.New("boom")
+				}
+				return nil
+			},
+			stopOnError: true,
+			wantCalls:   2,
+			wantErr:     "symbiont: periodic task: boom",
+		},
+		"invalid-interval": {
+			fn:      func(int) error { return nil },
+			wantErr: "symbiont: periodic task interval must be positive, got 0s",
+		},
+		"nil-function": {
+			interval: time.Millisecond,
+			nilFn:    true,
+			wantErr:  "symbiont: periodic task function must not be nil",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			logger := &recordingLogger{}
+			ctx, cancel := context.WithCancel(ContextWithLogger(context.Background(), logger))
+			defer cancel()
+
+			var (
+				mu    sync.Mutex
+				calls int
+			)
+			fn := func(context.Context) error {
+				mu.Lock()
+				calls++
+				n := calls
+				mu.Unlock()
+				if n == tt.wantCalls && !tt.stopOnError {
+					cancel()
+				}
+				return tt.fn(n)
+			}
+			if tt.nilFn {
+				fn = nil
+			}
+
+			task := PeriodicRunnable(tt.interval, 0, fn).StopOnError(tt.stopOnError)
+			errCh := make(chan error, 1)
+			go func() { errCh <- task.Run(ctx) }()
+
+			select {
+			case err := <-errCh:
+				gotErr := ""
+				if err != nil {
+					gotErr = err.Error()
+				}
+				if gotErr != tt.wantErr {
+					t.Fatalf("expected error %q, got %q", tt.wantErr, gotErr)
+				}
+			case <-time.After(2 * time.Second):
+				t.Fatal("expected Run to return")
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if calls != tt.wantCalls {
+				t.Fatalf("expected %d calls, got %d", tt.wantCalls, calls)
+			}
+			if !slices.Equal(tt.wantLogs, logger.entries) {
+				t.Fatalf("expected logs %v, got %v", tt.wantLogs, logger.entries)
+			}
+		})
+	}
+}
+
+func TestPeriodicRunnable_Jitter(t *testing.T) {
+	task := PeriodicRunnable(time.Second, 500*time.Millisecond, func(context.Context) error { return nil })
+	var maxes []time.Duration
+	task.randDuration = func(n time.Duration) time.Duration {
+		maxes = append(maxes, n)
+		return 200 * time.Millisecond
+	}
+	if got := task.nextDelay(); got != 1200*time.Millisecond {
+		t.Fatalf("expected interval plus jitter, got %s", got)
+	}
+	if !slices.Equal([]time.Duration{500 * time.Millisecond}, maxes) {
+		t.Fatalf("expected jitter to be drawn below 500ms, got %v", maxes)
+	}
+
+	noJitter := PeriodicRunnable(time.Second, 0, nil)
+	noJitter.randDuration = func(time.Duration) time.Duration {
+		t.Fatal("expected no random draw without jitter")
+		return 0
+	}
+	if got := noJitter.nextDelay(); got != time.Second {
+		t.Fatalf("expected exact interval, got %s", got)
+	}
+
+	// Real jitter stays within bounds.
+	for range 100 {
+		if d := PeriodicRunnable(time.Second, time.Second, nil).nextDelay(); d < time.Second || d >= 2*time.Second {
+			t.Fatalf("expected delay in [1s, 2s), got %s", d)
+		}
+	}
+}
+
+func TestPeriodicRunnable_HostedWithName(t *testing.T) {
+	task := PeriodicRunnable(time.Hour, 0, func(context.Context) error { return nil }).WithName("purge")
+	report := NewApp().Host(task).IntrospectionSnapshot()
+	if len(report.Runners) != 1 || !strings.HasSuffix(report.Runners[0].ID(), "[purge]") {
+		t.Fatalf("expected named periodic task in report, got %+v", report.Runners)
+	}
+}
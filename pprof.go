@@ -0,0 +1,88 @@
+package symbiont
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"sync"
+	"time"
+)
+
+// PprofServer is a Runnable that serves the net/http/pprof profiling endpoints under /debug/pprof/,
+// giving every service the same debug server without wiring it by hand.
+type PprofServer struct {
+	addr string
+
+	mu         sync.Mutex
+	listenAddr string
+	running    bool
+}
+
+// PprofRunnable creates a PprofServer listening on addr once hosted.
+// Profiles expose internals of the process, so addr should not be reachable publicly.
+func PprofRunnable(addr string) *PprofServer {
+	return &PprofServer{addr: addr}
+}
+
+// Run listens on the configured address and serves pprof endpoints until the context is canceled.
+func (p *PprofServer) Run(ctx context.Context) error {
+	p.mu.Lock()
+	if p.running {
+		p.mu.Unlock()
+		return errors.New("pprof: server is already running")
+	}
+	p.running = true
+	p.mu.Unlock()
+	defer func() {
+		p.mu.Lock()
+		p.running = false
+		p.listenAddr = ""
+		p.mu.Unlock()
+	}()
+
+	listener, err := net.Listen("tcp", p.addr)
+	if err != nil {
+		return fmt.Errorf("pprof: %w", err)
+	}
+
+	srv := &http.Server{Handler: p.Handler(), ReadHeaderTimeout: 10 * time.Second}
+
+	p.mu.Lock()
+	p.listenAddr = listener.Addr().String()
+	p.mu.Unlock()
+
+	if err := serveUntilDone(ctx, srv, listener); err != nil {
+		return fmt.Errorf("pprof: %w", err)
+	}
+	return nil
+}
+
+// IsReady reports whether the pprof server is listening.
+func (p *PprofServer) IsReady(context.Context) error {
+	if p.Addr() == "" {
+		return errors.New("pprof: server is not listening")
+	}
+	return nil
+}
+
+// Addr returns the address the server is listening on, or an empty string when it is not running.
+func (p *PprofServer) Addr() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.listenAddr
+}
+
+// Handler returns an http.Handler serving the pprof endpoints under /debug/pprof/.
+// It can be mounted on an existing mux instead of hosting the PprofServer.
+func (p *PprofServer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	return mux
+}
@@ -0,0 +1,76 @@
+package symbiont
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont/config"
+	"github.com/cleitonmarx/symbiont/depend"
+)
+
+func TestPprofRunnable(t *testing.T) {
+	depend.ClearContainer()
+	config.ResetGlobalProvider()
+	defer func() { depend.ClearContainer(); config.ResetGlobalProvider() }()
+
+	pp := PprofRunnable("127.0.0.1:0")
+	if err := pp.IsReady(context.Background()); err == nil {
+		t.Fatal("expected server not to be ready before Run")
+	}
+
+	app := NewApp().Host(pp)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errCh := app.RunAsync(ctx)
+	if err := app.WaitForReadiness(ctx, time.Second); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	tests := map[string]struct {
+		path     string
+		wantBody string
+	}{
+		"index":     {path: "/debug/pprof/", wantBody: "goroutine"},
+		"named":     {path: "/debug/pprof/goroutine?debug=1", wantBody: "goroutine profile"},
+		"cmdline":   {path: "/debug/pprof/cmdline"},
+		"symbol":    {path: "/debug/pprof/symbol", wantBody: "num_symbols"},
+		"heap-text": {path: "/debug/pprof/heap?debug=1", wantBody: "heap profile"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp, err := http.Get("http://" + pp.Addr() + tt.path)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			body, _ := io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("expected status 200, got %d", resp.StatusCode)
+			}
+			if !strings.Contains(string(body), tt.wantBody) {
+				t.Fatalf("expected body to contain %q, got:\n%s", tt.wantBody, body)
+			}
+		})
+	}
+
+	if err := pp.Run(ctx); err == nil || err.Error() != "pprof: server is already running" {
+		t.Fatalf("expected already running error, got %v", err)
+	}
+
+	cancel()
+	select {
+	case err := <-errCh:
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("app did not stop after context cancel")
+	}
+	if err := pp.IsReady(context.Background()); err == nil || pp.Addr() != "" {
+		t.Fatalf("expected server not to be listening after shutdown, got %v at %q", err, pp.Addr())
+	}
+}
@@ -3,15 +3,18 @@ package symbiont
 import (
 	"context"
 	"errors"
+	"fmt"
+	"reflect"
 	"sync/atomic"
 	"time"
 )
 
 // defaultReadyChecker is a default implementation of the ReadyChecker interface.
-// It marks the task as ready after the Run method has been called.
+// It marks the task as ready after the Run method has been called and, when set, the probe succeeds.
 type defaultReadyChecker struct {
 	started atomic.Bool
 	runable Runnable
+	probe   func(ctx context.Context) error
 }
 
 func (d *defaultReadyChecker) Run(ctx context.Context) error {
@@ -20,10 +23,13 @@ func (d *defaultReadyChecker) Run(ctx context.Context) error {
 }
 
 func (d *defaultReadyChecker) IsReady(ctx context.Context) error {
-	if d.started.Load() {
-		return nil
+	if !d.started.Load() {
+		return errors.New("not ready")
 	}
-	return errors.New("not ready")
+	if d.probe != nil {
+		return d.probe(ctx)
+	}
+	return nil
 }
 
 // WaitForReadiness polls all hosted runnables that implement the ReadyChecker interface until
@@ -33,60 +39,165 @@ func (d *defaultReadyChecker) IsReady(ctx context.Context) error {
 // canceled, it returns the context's error. If the timeout elapses and some runnable is still
 // not ready, it returns the last readiness error wrapped with the failing component via NewError.
 func (a *App) WaitForReadiness(ctx context.Context, timeout time.Duration) error {
-	// collect ready checkers
-	if len(a.runnableSpecsList) == 0 {
-		return nil
+	return a.waitForSpecs(ctx, timeout, a.hostedSpecs)
+}
+
+// defaultReadinessInterval is how often ready checkers are polled unless ReadinessOptions sets otherwise.
+const defaultReadinessInterval = 50 * time.Millisecond
+
+// ReadinessOptions configures how WaitForReadinessWith polls ready checkers.
+type ReadinessOptions struct {
+	// Timeout bounds the whole wait, as the timeout argument of WaitForReadiness does.
+	Timeout time.Duration
+	// InitialInterval is the delay between the first polls; <= 0 uses the default of 50ms.
+	InitialInterval time.Duration
+	// MaxInterval caps the delay between polls as it grows; <= 0 means no cap.
+	MaxInterval time.Duration
+	// BackoffFactor multiplies the delay after every poll; values <= 1 keep it constant.
+	BackoffFactor float64
+}
+
+// nextInterval returns the delay that follows interval.
+func (o ReadinessOptions) nextInterval(interval time.Duration) time.Duration {
+	if o.BackoffFactor <= 1 {
+		return interval
+	}
+	next := time.Duration(float64(interval) * o.BackoffFactor)
+	if o.MaxInterval > 0 && next > o.MaxInterval {
+		next = o.MaxInterval
 	}
+	return next
+}
 
-	waitCtx, cancel := context.WithTimeout(ctx, timeout)
-	defer cancel()
+// WaitForReadinessWith waits like WaitForReadiness, polling with the interval and backoff of opts.
+// A growing interval keeps expensive ready checks, such as an HTTP request in IsReady, from being
+// called in a tight loop while a service starts. Zero options other than Timeout behave like
+// WaitForReadiness.
+func (a *App) WaitForReadinessWith(ctx context.Context, opts ReadinessOptions) error {
+	_, _, err := a.pollReadiness(ctx, opts, a.hostedSpecs)
+	return err
+}
 
-	ticker := time.NewTicker(50 * time.Millisecond)
-	defer ticker.Stop()
+// WaitForRunnable polls only the ready checker of the given runnable, identified by the value
+// passed to Host, with the same timeout and cancellation semantics as WaitForReadiness.
+// Returns an error if the runnable was not hosted by the app.
+func (a *App) WaitForRunnable(ctx context.Context, r Runnable, timeout time.Duration) error {
+	for _, rs := range a.hostedSpecs() {
+		if sameRunnable(rs.original, r) {
+			return a.waitForSpecs(ctx, timeout, func() []runnableSpecs { return []runnableSpecs{rs} })
+		}
+	}
+	return NewError(errors.New("runnable is not hosted by the app"), r)
+}
 
-	var lastErr error
-	var lastFailing any
+// sameRunnable reports whether two runnables are the same hosted value.
+// Runnables of non-comparable types are never considered equal instead of panicking.
+func sameRunnable(a, b Runnable) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	ta := reflect.TypeOf(a)
+	return ta == reflect.TypeOf(b) && ta.Comparable() && a == b
+}
 
-	for {
+// WaitForReadinessDetailed waits like WaitForReadiness and also reports the readiness of every
+// hosted runnable, keyed by its type name. Runnables that became ready map to nil; the others map
+// to the last error their ready checker returned. When several runnables share a type, later ones
+// get a numeric suffix ("pkg.Worker#2"), in hosting order. Runnables that were never checked,
+// because the app was not running yet, are reported with a "not checked" error.
+func (a *App) WaitForReadinessDetailed(ctx context.Context, timeout time.Duration) (map[string]error, error) {
+	specs, statuses, err := a.pollReadiness(ctx, ReadinessOptions{Timeout: timeout}, a.hostedSpecs)
+	results := make(map[string]error, len(statuses))
+	for i, rs := range specs {
+		base := componentName(rs.original)
+		name := base
+		for n := 2; ; n++ {
+			if _, exists := results[name]; !exists {
+				break
+			}
+			name = fmt.Sprintf("%s#%d", base, n)
+		}
+		results[name] = statuses[i]
+	}
+	return results, err
+}
+
+// errNotChecked is the readiness status of a runnable whose ready checker has not been called.
+var errNotChecked = errors.New("not checked")
+
+// waitForSpecs polls the ready checkers of specs until all report ready, the timeout elapses,
+// the context is canceled, or the app stops running.
+func (a *App) waitForSpecs(ctx context.Context, timeout time.Duration, specs func() []runnableSpecs) error {
+	_, _, err := a.pollReadiness(ctx, ReadinessOptions{Timeout: timeout}, specs)
+	return err
+}
 
+// afterReadinessInterval returns a channel that receives once the delay between readiness polls elapses.
+func (a *App) afterReadinessInterval(interval time.Duration) <-chan time.Time {
+	if a.readinessAfter != nil {
+		return a.readinessAfter(interval)
+	}
+	return time.After(interval)
+}
+
+// pollReadiness polls the ready checkers of specs at the intervals of opts and returns the polled
+// specs and, alongside the result, the last readiness error of each spec (nil once ready), in the
+// same order. specs is called again once the app is running, so runnables hosted by HostResolved
+// after the initializers ran are polled too.
+func (a *App) pollReadiness(ctx context.Context, opts ReadinessOptions, specs func() []runnableSpecs) ([]runnableSpecs, []error, error) {
+	polled := specs()
+	statuses := make([]error, len(polled))
+	for i := range statuses {
+		statuses[i] = errNotChecked
+	}
+	if len(polled) == 0 && len(a.resolvedHosts) == 0 {
+		return polled, statuses, nil
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	interval := opts.InitialInterval
+	if interval <= 0 {
+		interval = defaultReadinessInterval
+	}
+	for {
 		// If the app is already running, check all ready checkers once.
 		if a.isRunning.Load() {
-			// check all
+			if polled = specs(); len(polled) != len(statuses) {
+				statuses = make([]error, len(polled))
+			}
 			allReady := true
-			for _, c := range a.runnableSpecsList {
-				if err := c.readyChecker.IsReady(waitCtx); err != nil {
-					lastErr = err
-					lastFailing = c.original
+			for i, c := range polled {
+				statuses[i] = c.readyChecker.IsReady(waitCtx)
+				if statuses[i] != nil {
 					allReady = false
-					break
 				}
 			}
 			if allReady {
-				return nil
+				return polled, statuses, nil
 			}
 		}
-
 		select {
 		case err := <-a.errCh:
 			// If the app has stopped running, return its final error
-			return err
+			return polled, statuses, err
 		case <-waitCtx.Done():
 			// If the parent context was canceled, prefer returning that cancellation error.
 			if waitCtx.Err() == context.Canceled {
-				return waitCtx.Err()
+				return polled, statuses, waitCtx.Err()
 			}
-			// If the timeout elapsed (deadline exceeded), return the last readiness error wrapped
-			// with the failing component when available; otherwise return the context error.
-			if waitCtx.Err() == context.DeadlineExceeded {
-				if lastFailing != nil && lastErr != nil {
-					return NewError(lastErr, lastFailing)
+			// If the timeout elapsed, return the readiness error of the first runnable that is
+			// still not ready, wrapped with the component; otherwise return the context error.
+			for i, status := range statuses {
+				if status != nil && status != errNotChecked {
+					return polled, statuses, newPhaseError(status, polled[i].original, PhaseRun)
 				}
-				return waitCtx.Err()
 			}
-			// fallback: return the context error
-			return waitCtx.Err()
-		case <-ticker.C:
-			// try again
+			return polled, statuses, waitCtx.Err()
+		case <-a.afterReadinessInterval(interval):
+			// try again, backing off if configured
+			interval = opts.nextInterval(interval)
 		}
 	}
 }
@@ -3,7 +3,9 @@ package symbiont
 import (
 	"context"
 	"errors"
+	"reflect"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -179,3 +181,249 @@ func TestWaitForReadiness(t *testing.T) {
 		})
 	}
 }
+
+func TestWaitForRunnable(t *testing.T) {
+	ready := &immediatelyReady{}
+	notReady := &alwaysNotReady{}
+
+	tests := map[string]struct {
+		target    Runnable
+		timeout   time.Duration
+		expectMsg string
+	}{
+		"hosted-runnable-ready": {
+			target:  ready,
+			timeout: 500 * time.Millisecond,
+		},
+		"hosted-runnable-times-out": {
+			target:    notReady,
+			timeout:   100 * time.Millisecond,
+			expectMsg: "error: never ready, component: *symbiont.alwaysNotReady",
+		},
+		"runnable-not-hosted": {
+			target:    &eventuallyReady{},
+			timeout:   100 * time.Millisecond,
+			expectMsg: "error: runnable is not hosted by the app, component: *symbiont.eventuallyReady",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := NewApp().Host(notReady, ready)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			errCh := a.RunAsync(ctx)
+
+			err := a.WaitForRunnable(ctx, tt.target, tt.timeout)
+			if tt.expectMsg == "" && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if tt.expectMsg != "" && (err == nil || err.Error() != tt.expectMsg) {
+				t.Fatalf("expected error %q, got %v", tt.expectMsg, err)
+			}
+
+			cancel()
+			select {
+			case <-errCh:
+			case <-time.After(1 * time.Second):
+				t.Fatal("RunAsync did not complete")
+			}
+		})
+	}
+}
+
+func TestWaitForReadinessDetailed(t *testing.T) {
+	tests := map[string]struct {
+		runnables  []Runnable
+		wantStatus map[string]string
+		wantErr    string
+	}{
+		"all-ready": {
+			runnables:  []Runnable{&immediatelyReady{}, &eventuallyReady{readyAfter: 3}},
+			wantStatus: map[string]string{"*symbiont.immediatelyReady": "", "*symbiont.eventuallyReady": ""},
+		},
+		"reports-each-runnable-on-timeout": {
+			runnables: []Runnable{&alwaysNotReady{}, &immediatelyReady{}, &alwaysNotReady{}},
+			wantStatus: map[string]string{
+				"*symbiont.alwaysNotReady":   "never ready",
+				"*symbiont.immediatelyReady": "",
+				"*symbiont.alwaysNotReady#2": "never ready",
+			},
+			wantErr: "error: never ready, component: *symbiont.alwaysNotReady",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := NewApp().Host(tt.runnables...)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			errCh := a.RunAsync(ctx)
+
+			statuses, err := a.WaitForReadinessDetailed(ctx, 200*time.Millisecond)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr) {
+				t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+			}
+			got := make(map[string]string, len(statuses))
+			for name, status := range statuses {
+				got[name] = ""
+				if status != nil {
+					got[name] = status.Error()
+				}
+			}
+			if !reflect.DeepEqual(tt.wantStatus, got) {
+				t.Fatalf("expected statuses %v, got %v", tt.wantStatus, got)
+			}
+
+			cancel()
+			<-errCh
+		})
+	}
+}
+
+// warmupRunnable runs until canceled and has no ReadyChecker of its own
+type warmupRunnable struct{}
+
+func (w *warmupRunnable) Run(ctx context.Context) error { <-ctx.Done(); return nil }
+
+func TestApp_HostWithReady(t *testing.T) {
+	var warmedUp atomic.Bool
+	tests := map[string]struct {
+		runnable  Runnable
+		probe     func(context.Context) error
+		warmup    bool
+		expectMsg string
+	}{
+		"probe-becomes-ready": {
+			runnable: &warmupRunnable{},
+			probe: func(context.Context) error {
+				if !warmedUp.Load() {
+					return errors.New("warming up")
+				}
+				return nil
+			},
+			warmup: true,
+		},
+		"probe-never-ready": {
+			runnable:  &warmupRunnable{},
+			probe:     func(context.Context) error { return errors.New("warming up") },
+			expectMsg: "error: warming up, component: *symbiont.warmupRunnable",
+		},
+		"probe-overrides-ready-checker": {
+			runnable:  &immediatelyReady{},
+			probe:     func(context.Context) error { return errors.New("probe failed") },
+			expectMsg: "error: probe failed, component: *symbiont.immediatelyReady",
+		},
+		"nil-probe-behaves-like-host": {
+			runnable: &warmupRunnable{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			warmedUp.Store(false)
+			a := NewApp().HostWithReady(tt.runnable, tt.probe)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			errCh := a.RunAsync(ctx)
+
+			if tt.warmup {
+				time.AfterFunc(100*time.Millisecond, func() { warmedUp.Store(true) })
+			}
+			err := a.WaitForReadiness(ctx, 300*time.Millisecond)
+			if tt.expectMsg == "" && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if tt.expectMsg != "" && (err == nil || err.Error() != tt.expectMsg) {
+				t.Fatalf("expected error %q, got %v", tt.expectMsg, err)
+			}
+
+			cancel()
+			select {
+			case <-errCh:
+			case <-time.After(1 * time.Second):
+				t.Fatal("RunAsync did not complete")
+			}
+		})
+	}
+}
+
+// countingNotReady is a runnable that never becomes ready and counts its readiness checks
+type countingNotReady struct{ checks atomic.Int32 }
+
+func (c *countingNotReady) Run(ctx context.Context) error { <-ctx.Done(); return nil }
+func (c *countingNotReady) IsReady(ctx context.Context) error {
+	c.checks.Add(1)
+	return errors.New("warming up")
+}
+
+func TestWaitForReadinessWith(t *testing.T) {
+	const ms = time.Millisecond
+	tests := map[string]struct {
+		opts          ReadinessOptions
+		wantIntervals []time.Duration
+	}{
+		"default-interval": {
+			opts:          ReadinessOptions{},
+			wantIntervals: []time.Duration{50 * ms, 50 * ms, 50 * ms},
+		},
+		"constant-interval": {
+			opts:          ReadinessOptions{InitialInterval: 10 * ms},
+			wantIntervals: []time.Duration{10 * ms, 10 * ms, 10 * ms, 10 * ms},
+		},
+		"exponential-backoff": {
+			opts:          ReadinessOptions{InitialInterval: 10 * ms, BackoffFactor: 2},
+			wantIntervals: []time.Duration{10 * ms, 20 * ms, 40 * ms, 80 * ms},
+		},
+		"backoff-capped-by-max-interval": {
+			opts:          ReadinessOptions{InitialInterval: 10 * ms, MaxInterval: 20 * ms, BackoffFactor: 10},
+			wantIntervals: []time.Duration{10 * ms, 20 * ms, 20 * ms, 20 * ms},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := &countingNotReady{}
+			a := NewApp().Host(r)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			errCh := a.RunAsync(ctx)
+			for !a.isRunning.Load() {
+				time.Sleep(time.Millisecond)
+			}
+
+			// Every wait returns at once, until the expected number of waits cancels the poll.
+			waitCtx, stopWaiting := context.WithCancel(ctx)
+			defer stopWaiting()
+			var intervals []time.Duration
+			a.readinessAfter = func(d time.Duration) <-chan time.Time {
+				intervals = append(intervals, d)
+				if len(intervals) == len(tt.wantIntervals) {
+					stopWaiting()
+					return nil
+				}
+				fired := make(chan time.Time, 1)
+				fired <- time.Time{}
+				return fired
+			}
+
+			tt.opts.Timeout = time.Minute
+			err := a.WaitForReadinessWith(waitCtx, tt.opts)
+			if !errors.Is(err, context.Canceled) {
+				t.Fatalf("expected context.Canceled, got %v", err)
+			}
+			if !reflect.DeepEqual(tt.wantIntervals, intervals) {
+				t.Fatalf("expected intervals %v, got %v", tt.wantIntervals, intervals)
+			}
+			if got, want := r.checks.Load(), int32(len(tt.wantIntervals)); got != want {
+				t.Fatalf("expected %d readiness checks, got %d", want, got)
+			}
+
+			cancel()
+			<-errCh
+		})
+	}
+}
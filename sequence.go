@@ -0,0 +1,80 @@
+package symbiont
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// compositeRunnable is implemented by runnables that run other runnables, such as Sequence.
+// The app wires the struct fields of the inner runnables and registers their closers as if they
+// were hosted directly.
+type compositeRunnable interface {
+	innerRunnables() []Runnable
+}
+
+// SequenceGroup is a Runnable that runs its steps one after another.
+type SequenceGroup struct {
+	steps []Runnable
+}
+
+// Sequence returns a SequenceGroup that runs each runnable to completion, in order, as a single hosted
+// unit, for one-shot pipelines such as "migrate, then seed":
+//
+//	app := symbiont.NewApp().
+//		Host(symbiont.Sequence(&Migrate{}, &Seed{}), &Server{})
+//
+// Run stops at the first step that returns an error and returns that error, naming the step.
+// Once the context is canceled no further step is started, and Run returns nil.
+// Nil runnables are ignored. Hosting a sequence applies the checks of Host to every step: a typed
+// nil step, or a step already hosted, makes Run fail before any initializer runs.
+//
+// The steps are wired and their closers registered by the app like hosted runnables, but they are
+// not reported as runnables of their own: introspection shows the sequence, named after its steps,
+// and readiness and observers see it as one unit.
+func Sequence(runnables ...Runnable) *SequenceGroup {
+	steps := make([]Runnable, 0, len(runnables))
+	for _, r := range runnables {
+		if r != nil {
+			steps = append(steps, r)
+		}
+	}
+	return &SequenceGroup{steps: steps}
+}
+
+// Run runs the steps in order until one fails or the context is canceled.
+func (s *SequenceGroup) Run(ctx context.Context) error {
+	for i, step := range s.steps {
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err := step.Run(ctx); err != nil {
+			return fmt.Errorf("symbiont: sequence step %d (%s): %w", i+1, componentName(step), err)
+		}
+	}
+	return nil
+}
+
+// Name lists the steps, so introspection tells sequences apart.
+func (s *SequenceGroup) Name() string {
+	names := make([]string, 0, len(s.steps))
+	for _, step := range s.steps {
+		names = append(names, componentName(step))
+	}
+	return strings.Join(names, " -> ")
+}
+
+func (s *SequenceGroup) innerRunnables() []Runnable {
+	return s.steps
+}
+
+// hostedComponents returns a hosted runnable followed by the runnables it composes, depth first.
+func hostedComponents(r Runnable) []Runnable {
+	components := []Runnable{r}
+	if c, ok := r.(compositeRunnable); ok {
+		for _, inner := range c.innerRunnables() {
+			components = append(components, hostedComponents(inner)...)
+		}
+	}
+	return components
+}
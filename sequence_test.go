@@ -0,0 +1,168 @@
+package symbiont
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/cleitonmarx/symbiont/depend"
+)
+
+// stepRunnable records its name when run and returns err.
+type stepRunnable struct {
+	name  string
+	log   *[]string
+	err   error
+	onRun func()
+}
+
+func (s *stepRunnable) Run(context.Context) error {
+	*s.log = append(*s.log, s.name)
+	if s.onRun != nil {
+		s.onRun()
+	}
+	return s.err
+}
+
+func TestSequence_Run(t *testing.T) {
+	tests := map[string]struct {
+		steps    func(log *[]string, cancel context.CancelFunc) []Runnable
+		canceled bool
+		wantLog  []string
+		wantErr  string
+	}{
+		"runs-in-order": {
+			steps: func(log *[]string, _ context.CancelFunc) []Runnable {
+				return []Runnable{&stepRunnable{name: "a", log: log}, nil, &stepRunnable{name: "b", log: log}}
+			},
+			wantLog: []string{"a", "b"},
+		},
+		"stops-on-first-error": {
+			steps: func(log *[]string, _ context.CancelFunc) []Runnable {
+				return []Runnable{
+					&stepRunnable{name: "a", log: log},
+					&stepRunnable{name: "b", log: log, err: errors.New("boom")},
+					&stepRunnable{name: "c", log: log},
+				}
+			},
+			wantLog: []string{"a", "b"},
+			wantErr: "symbiont: sequence step 2 (*symbiont.stepRunnable): boom",
+		},
+		"canceled-between-steps": {
+			steps: func(log *[]string, cancel context.CancelFunc) []Runnable {
+				return []Runnable{&stepRunnable{name: "a", log: log, onRun: cancel}, &stepRunnable{name: "b", log: log}}
+			},
+			wantLog: []string{"a"},
+		},
+		"canceled-before-start": {
+			steps: func(log *[]string, _ context.CancelFunc) []Runnable {
+				return []Runnable{&stepRunnable{name: "a", log: log}}
+			},
+			canceled: true,
+		},
+		"empty": {
+			steps: func(*[]string, context.CancelFunc) []Runnable { return nil },
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.canceled {
+				cancel()
+			}
+			var log []string
+
+			err := Sequence(tt.steps(&log, cancel)...).Run(ctx)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr) {
+				t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+			}
+			if !slices.Equal(log, tt.wantLog) {
+				t.Fatalf("expected steps %v, got %v", tt.wantLog, log)
+			}
+		})
+	}
+}
+
+func TestSequence_Name(t *testing.T) {
+	var log []string
+	seq := Sequence(&stepRunnable{log: &log}, &waitRunnable{})
+	if got, want := seq.Name(), "*symbiont.stepRunnable -> *symbiont.waitRunnable"; got != want {
+		t.Fatalf("expected name %q, got %q", want, got)
+	}
+}
+
+func TestApp_HostSequence(t *testing.T) {
+	depend.ClearContainer()
+	defer depend.ClearContainer()
+
+	var got string
+	var closed []string
+	resolve := &resolveDepRun{gotVal: &got}
+	inner := &runCloser{name: "inner", log: &closed}
+	app := NewApp().
+		Initialize(&depRegisterInitializer{value: "hello"}).
+		Host(Sequence(resolve, Sequence(inner)))
+
+	if err := app.CheckDependencies(); err == nil {
+		t.Fatal("expected the step's resolve tag to be checked before the dependency is registered")
+	}
+	if err := app.RunWithContext(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got != "hello" {
+		t.Fatalf("expected the step to be wired with %q, got %q", "hello", got)
+	}
+	if !slices.Equal(closed, []string{"inner"}) {
+		t.Fatalf("expected the nested step to be closed, got %v", closed)
+	}
+}
+
+func TestApp_HostSequence_ChecksSteps(t *testing.T) {
+	shared := &runCloser{name: "shared", log: &[]string{}}
+	tests := map[string]struct {
+		runs    []Runnable
+		wantErr string
+	}{
+		"typed-nil-step": {
+			runs:    []Runnable{Sequence(&runCloser{name: "a", log: &[]string{}}, (*runCloser)(nil))},
+			wantErr: "error: nil runnable composed by *symbiont.SequenceGroup, component: *symbiont.runCloser",
+		},
+		"typed-nil-nested-sequence": {
+			runs:    []Runnable{Sequence(&runCloser{name: "a", log: &[]string{}}, (*SequenceGroup)(nil))},
+			wantErr: "error: nil runnable composed by *symbiont.SequenceGroup, component: *symbiont.SequenceGroup",
+		},
+		"step-repeated-in-sequence": {
+			runs:    []Runnable{Sequence(shared, shared)},
+			wantErr: "error: runnable passed to Host more than once, component: *symbiont.runCloser",
+		},
+		"step-also-hosted-directly": {
+			runs:    []Runnable{shared, Sequence(shared)},
+			wantErr: "error: runnable passed to Host more than once, component: *symbiont.runCloser",
+		},
+		"step-hosted-after-sequence": {
+			runs:    []Runnable{Sequence(Sequence(shared)), shared},
+			wantErr: "error: runnable passed to Host more than once, component: *symbiont.runCloser",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			depend.ClearContainer()
+			defer depend.ClearContainer()
+
+			err := NewApp().
+				Initialize(&panicInitializer{}).
+				Host(tt.runs...).
+				RunWithContext(context.Background())
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
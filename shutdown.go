@@ -0,0 +1,73 @@
+package symbiont
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultShutdownTimeout is the deadline of contexts returned by ShutdownContext
+// when the app does not set one with WithShutdownTimeout.
+const DefaultShutdownTimeout = 10 * time.Second
+
+// ShutdownSummary describes a completed shutdown, as returned by RunWithSummary.
+type ShutdownSummary struct {
+	// Duration is the time from the start of shutdown until every closer returned.
+	Duration time.Duration
+	// Closers holds the result of each closer, in the order they ran.
+	Closers []CloserResult
+}
+
+// CloserResult is the outcome of a single closer during shutdown.
+// Functions registered with RegisterCloser are reported under their initializer.
+type CloserResult struct {
+	Component string
+	Duration  time.Duration
+	Err       error
+}
+
+// shutdownTimeoutKey is the context key under which the app stores its shutdown timeout.
+type shutdownTimeoutKey struct{}
+
+// WithShutdownTimeout sets the deadline of the contexts runnables obtain from ShutdownContext and of the
+// context passed to ContextCloser components (fluent method). A timeout <= 0 restores DefaultShutdownTimeout.
+func (a *App) WithShutdownTimeout(d time.Duration) *App {
+	a.shutdownTimeout = d
+	return a
+}
+
+// ShutdownContext returns a context for clean-up work after the run context passed to Run is cancelled,
+// such as http.Server.Shutdown. It keeps the values of ctx but not its cancellation, and expires after
+// the app's shutdown timeout. The caller must call the returned cancel function.
+//
+//	case <-ctx.Done():
+//		shutdownCtx, cancel := symbiont.ShutdownContext(ctx)
+//		defer cancel()
+//		return srv.Shutdown(shutdownCtx)
+func ShutdownContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	timeout, _ := ctx.Value(shutdownTimeoutKey{}).(time.Duration)
+	return newShutdownContext(ctx, timeout)
+}
+
+// shutdownContext returns the context passed to closers when the app shuts down.
+func (a *App) shutdownContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return newShutdownContext(ctx, a.shutdownTimeout)
+}
+
+// newShutdownContext detaches ctx from its cancellation and bounds it by timeout,
+// falling back to DefaultShutdownTimeout when timeout <= 0. The context also ends when Run
+// forces the shutdown after a second signal.
+func newShutdownContext(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		timeout = DefaultShutdownTimeout
+	}
+	shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+	forceCtx, ok := ctx.Value(forceQuitKey{}).(context.Context)
+	if !ok {
+		return shutdownCtx, cancel
+	}
+	stop := context.AfterFunc(forceCtx, cancel)
+	return shutdownCtx, func() {
+		stop()
+		cancel()
+	}
+}
@@ -0,0 +1,121 @@
+package symbiont
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont/depend"
+)
+
+// shutdownRecorder captures the shutdown context obtained after its run context is cancelled.
+type shutdownRecorder struct {
+	gotErr      error
+	gotDeadline time.Duration
+	gotVal      any
+}
+
+func (s *shutdownRecorder) Run(ctx context.Context) error {
+	<-ctx.Done()
+	shutdownCtx, cancel := ShutdownContext(ctx)
+	defer cancel()
+	s.gotErr = shutdownCtx.Err()
+	deadline, _ := shutdownCtx.Deadline()
+	s.gotDeadline = time.Until(deadline)
+	s.gotVal = shutdownCtx.Value(testContextKey)
+	return nil
+}
+
+func TestShutdownContext(t *testing.T) {
+	tests := map[string]struct {
+		timeout      time.Duration
+		wantDeadline time.Duration
+	}{
+		"default-timeout": {
+			wantDeadline: DefaultShutdownTimeout,
+		},
+		"custom-timeout": {
+			timeout:      time.Minute,
+			wantDeadline: time.Minute,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			depend.ClearContainer()
+			ctx, cancel := context.WithCancel(context.WithValue(context.Background(), testContextKey, "kept"))
+			rec := &shutdownRecorder{}
+			errCh := NewApp().WithShutdownTimeout(tt.timeout).Host(rec).RunAsync(ctx)
+			cancel()
+			if err := <-errCh; err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if rec.gotErr != nil {
+				t.Fatalf("expected a live shutdown context, got %v", rec.gotErr)
+			}
+			if rec.gotDeadline > tt.wantDeadline || rec.gotDeadline < tt.wantDeadline-time.Second {
+				t.Fatalf("expected deadline about %v away, got %v", tt.wantDeadline, rec.gotDeadline)
+			}
+			if rec.gotVal != "kept" {
+				t.Fatalf("expected context values to be kept, got %v", rec.gotVal)
+			}
+		})
+	}
+}
+
+// slowCloser is a runnable whose Close blocks for delay.
+type slowCloser struct {
+	delay time.Duration
+}
+
+func (*slowCloser) Run(context.Context) error { return nil }
+
+func (s *slowCloser) Close() { time.Sleep(s.delay) }
+
+func TestApp_RunWithSummary(t *testing.T) {
+	depend.ClearContainer()
+	defer depend.ClearContainer()
+
+	var log []string
+	closeErr := errors.New("flush failed")
+	summary, err := NewApp().
+		Initialize(&registeringInitializer{name: "A", fns: []string{"a1"}, log: &log}).
+		Host(&ctxCloserRunnable{name: "B", log: &log, closeErr: closeErr}, &slowCloser{delay: 20 * time.Millisecond}).
+		RunWithSummary(context.Background())
+
+	if err == nil || !strings.Contains(err.Error(), "flush failed") {
+		t.Fatalf("expected close error to be returned, got %v", err)
+	}
+
+	var got []string
+	for _, r := range summary.Closers {
+		got = append(got, r.Component)
+	}
+	want := []string{"*symbiont.slowCloser", "*symbiont.ctxCloserRunnable", "*symbiont.registeringInitializer"}
+	if !slices.Equal(want, got) {
+		t.Fatalf("expected closers %v, got %v", want, got)
+	}
+	if summary.Closers[0].Duration < 20*time.Millisecond {
+		t.Fatalf("expected slow closer duration of at least 20ms, got %v", summary.Closers[0].Duration)
+	}
+	if summary.Closers[1].Err == nil || summary.Closers[0].Err != nil || summary.Closers[2].Err != nil {
+		t.Fatalf("expected only the context closer to fail, got %+v", summary.Closers)
+	}
+	if summary.Duration < summary.Closers[0].Duration {
+		t.Fatalf("expected shutdown duration %v to cover the closers", summary.Duration)
+	}
+}
+
+func TestApp_RunWithSummary_NoClosers(t *testing.T) {
+	summary, err := NewApp().RunWithSummary(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(summary.Closers) != 0 || summary.Duration <= 0 {
+		t.Fatalf("expected a timed summary without closers, got %+v", summary)
+	}
+}
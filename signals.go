@@ -0,0 +1,84 @@
+package symbiont
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"time"
+)
+
+// forceQuitWindow is how soon after a signal the same signal must arrive again to force the shutdown.
+var forceQuitWindow = 2 * time.Second
+
+// ErrShutdownForced is returned by Run when a repeated signal forced the shutdown.
+var ErrShutdownForced = errors.New("symbiont: shutdown forced by a repeated signal")
+
+// forceQuitKey is the context key under which Run stores the context cancelled to force the shutdown.
+type forceQuitKey struct{}
+
+// WithForceQuitOnSecondSignal makes a second identical termination signal received by Run force the
+// shutdown (fluent method), like pressing Ctrl-C again in most CLIs. The first signal starts a graceful
+// shutdown as usual; the same signal arriving again within two seconds expires the contexts passed to
+// closers and returned by ShutdownContext at once, and Run returns ErrShutdownForced without waiting
+// for the runnables and closers still running. A different signal, such as a SIGINT delivered right
+// after a SIGTERM, or one arriving later, only restarts the window.
+// By default every signal after the first is ignored.
+func (a *App) WithForceQuitOnSecondSignal(enabled bool) *App {
+	a.forceQuitOnSecondSignal = enabled
+	return a
+}
+
+// runUntilForced runs the app like runWithContext, but returns ErrShutdownForced as soon as forceCtx is
+// cancelled by a repeated signal, leaving the runnables and closers that have not finished behind.
+func (a *App) runUntilForced(ctx, forceCtx context.Context) error {
+	errCh := make(chan error, 1)
+	go func() { errCh <- a.runWithContext(ctx, nil) }()
+	select {
+	case err := <-errCh:
+		return err
+	case <-forceCtx.Done():
+		return ErrShutdownForced
+	}
+}
+
+// signalContext returns a context cancelled by the first of the given signals. With force quit enabled,
+// the context also carries a force-quit context that a repeated signal cancels.
+func (a *App) signalContext(signals ...os.Signal) (context.Context, context.CancelFunc) {
+	if !a.forceQuitOnSecondSignal {
+		return signal.NotifyContext(context.Background(), signals...)
+	}
+
+	forceCtx, force := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), forceQuitKey{}, forceCtx))
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, signals...)
+	done := make(chan struct{})
+	window := forceQuitWindow
+	go func() {
+		var (
+			last   os.Signal
+			lastAt time.Time
+		)
+		for {
+			select {
+			case <-done:
+				return
+			case sig := <-sigCh:
+				if sig == last && time.Since(lastAt) < window {
+					a.appLogger().Warn("second signal received, forcing shutdown", "signal", sig.String())
+					force()
+					return
+				}
+				last, lastAt = sig, time.Now()
+				cancel()
+			}
+		}
+	}()
+	return ctx, func() {
+		signal.Stop(sigCh)
+		close(done)
+		cancel()
+		force()
+	}
+}
@@ -0,0 +1,123 @@
+package symbiont
+
+import (
+	"context"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont/config"
+	"github.com/cleitonmarx/symbiont/depend"
+)
+
+// shutdownWaitRunnable waits on its shutdown context after the run context is cancelled,
+// as a server draining connections would, and reports how that wait ended.
+type shutdownWaitRunnable struct {
+	started chan struct{}
+	result  chan error
+}
+
+func (r *shutdownWaitRunnable) Run(ctx context.Context) error {
+	close(r.started)
+	<-ctx.Done()
+	shutdownCtx, cancel := ShutdownContext(ctx)
+	defer cancel()
+	select {
+	case <-shutdownCtx.Done():
+		r.result <- shutdownCtx.Err()
+	case <-time.After(300 * time.Millisecond):
+		r.result <- nil
+	}
+	return nil
+}
+
+// blockingCloser is a runnable whose Close ignores the shutdown context and blocks until released.
+type blockingCloser struct{ release chan struct{} }
+
+func (b *blockingCloser) Run(ctx context.Context) error { <-ctx.Done(); return nil }
+func (b *blockingCloser) Close()                        { <-b.release }
+
+func TestApp_WithForceQuitOnSecondSignal(t *testing.T) {
+	depend.ClearContainer()
+	config.ResetGlobalProvider()
+	defer func() { depend.ClearContainer(); config.ResetGlobalProvider() }()
+
+	defer func(d time.Duration) { forceQuitWindow = d }(forceQuitWindow)
+	forceQuitWindow = 100 * time.Millisecond
+
+	tests := map[string]struct {
+		enabled   bool
+		second    os.Signal
+		secondGap time.Duration
+		// blocking hosts a closer that ignores the shutdown context, so Run only returns if forced
+		blocking   bool
+		wantErr    error
+		wantRunErr error
+	}{
+		"repeated-signal-forces-shutdown": {
+			enabled:    true,
+			second:     os.Interrupt,
+			secondGap:  10 * time.Millisecond,
+			blocking:   true,
+			wantErr:    context.Canceled,
+			wantRunErr: ErrShutdownForced,
+		},
+		"repeated-signal-after-window-is-ignored": {
+			enabled:   true,
+			second:    os.Interrupt,
+			secondGap: 200 * time.Millisecond,
+		},
+		"different-signal-is-ignored": {
+			enabled:   true,
+			second:    syscall.SIGTERM,
+			secondGap: 10 * time.Millisecond,
+		},
+		"disabled-ignores-second-signal": {
+			second:    os.Interrupt,
+			secondGap: 10 * time.Millisecond,
+		},
+	}
+
+	proc, _ := os.FindProcess(os.Getpid())
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := &shutdownWaitRunnable{started: make(chan struct{}), result: make(chan error, 1)}
+			app := NewApp().
+				WithShutdownTimeout(10 * time.Second).
+				WithForceQuitOnSecondSignal(tt.enabled).
+				Host(r)
+			if tt.blocking {
+				release := make(chan struct{})
+				defer close(release)
+				app.Host(&blockingCloser{release: release})
+			}
+
+			errCh := make(chan error, 1)
+			go func() { errCh <- app.Run() }()
+			<-r.started
+			time.Sleep(10 * time.Millisecond)
+
+			_ = proc.Signal(os.Interrupt)
+			time.Sleep(tt.secondGap)
+			_ = proc.Signal(tt.second)
+
+			select {
+			case err := <-r.result:
+				if err != tt.wantErr {
+					t.Fatalf("expected shutdown context error %v, got %v", tt.wantErr, err)
+				}
+			case <-time.After(2 * time.Second):
+				t.Fatal("expected the runnable to finish shutting down")
+			}
+			select {
+			case err := <-errCh:
+				if err != tt.wantRunErr {
+					t.Fatalf("expected Run error %v, got %v", tt.wantRunErr, err)
+				}
+			case <-time.After(2 * time.Second):
+				t.Fatal("expected Run to return")
+			}
+		})
+	}
+}
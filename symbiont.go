@@ -2,13 +2,17 @@
 package symbiont
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
 	"os"
-	"os/signal"
 	"reflect"
+	"slices"
+	"sync"
 	"sync/atomic"
 	"syscall"
+	"time"
 
 	"github.com/cleitonmarx/symbiont/config"
 	"github.com/cleitonmarx/symbiont/depend"
@@ -26,10 +30,15 @@ type runnableSpecs struct {
 	original Runnable
 	// readyChecker is the health check for this runnable
 	readyChecker ReadyChecker
+	// isolated runnables are restarted on failure instead of stopping the app
+	isolated bool
 }
 
-// closerFunc is a function that performs cleanup operations.
-type closerFunc func()
+// isolatedRestartDelay is how long an isolated runnable waits before restarting after a failure.
+var isolatedRestartDelay = time.Second
+
+// closerFunc is a function that performs cleanup operations within the shutdown context.
+type closerFunc func(ctx context.Context) error
 
 // App orchestrates application lifecycle: initialization, concurrent execution, and graceful shutdown.
 type App struct {
@@ -38,61 +47,239 @@ type App struct {
 	introspectors     []Introspector
 	errCh             chan error
 	isRunning         atomic.Bool
+	started           atomic.Bool
+	logger            Logger
+	initTimeout       time.Duration
+	shutdownTimeout   time.Duration
+	requireConfig     bool
+	// strictInitContext makes an initializer returning a nil context without an error fail startup
+	strictInitContext bool
+	// forceQuitOnSecondSignal makes a second signal received by Run expire the shutdown contexts
+	forceQuitOnSecondSignal bool
+	events                  *eventStream
+	tracer                  Tracer
+	// exits records the runnable exits of the current run, reported by IntrospectionSnapshot
+	exits runnableExitLog
+	// buildErrs records invalid arguments passed to fluent methods, reported when the app runs
+	buildErrs []error
+	// specsMu guards runnableSpecsList, which hostResolved extends while the app runs
+	specsMu sync.RWMutex
+	// resolvedHosts resolve the runnables of HostResolved calls once the initializers have run
+	resolvedHosts []func() []Runnable
+	// readinessAfter waits between readiness polls, time.After when nil; tests replace it to poll without sleeping
+	readinessAfter func(time.Duration) <-chan time.Time
 }
 
 // NewApp creates a new application with no initializers or runnables.
 func NewApp() *App {
-	return &App{}
+	return &App{
+		logger: noopLogger{},
+	}
 }
 
 // Initialize adds initializers to the app (fluent method).
 // Initializers run sequentially before runnables; use this to set up resources and register dependencies.
+// Nil initializers are ignored; typed nil pointers make Run fail before any initializer executes.
 func (a *App) Initialize(init ...Initializer) *App {
 	for _, init := range init {
 		if init == nil {
 			continue
 		}
+		if reflectx.IsNil(init) {
+			a.buildErrs = append(a.buildErrs, NewError(errors.New("nil initializer passed to Initialize"), init))
+			continue
+		}
 		a.initializers = append(a.initializers, init)
 	}
 	return a
 }
 
+// WithInitTimeout bounds how long each initializer may run (fluent method).
+// Each Initialize call receives a context with the deadline; if it does not return in time,
+// Run fails with an InitTimeoutError. Initializers that ignore context cancellation are abandoned
+// and their goroutine keeps running in the background until Initialize returns.
+// A timeout <= 0 disables the limit, which is the default.
+func (a *App) WithInitTimeout(d time.Duration) *App {
+	a.initTimeout = d
+	return a
+}
+
+// RequireConfig makes wiring report every missing or invalid configuration value at once (fluent method).
+// Instead of failing on the first config field, all config failures of hosted runnables and
+// introspectors are collected and returned as a single joined error before any runnable starts.
+// An initializer with config failures still stops startup before it runs, reporting all of its failures.
+func (a *App) RequireConfig() *App {
+	a.requireConfig = true
+	return a
+}
+
+// WithStrictInitContext makes an initializer that returns a nil context without an error fail startup
+// (fluent method). Returning (nil, nil) from Initialize keeps the previous context, so values the
+// initializer meant to add are silently lost; by default the app only logs a warning.
+func (a *App) WithStrictInitContext(strict bool) *App {
+	a.strictInitContext = strict
+	return a
+}
+
 // Host adds runnables to the app (fluent method).
 // Runnables execute concurrently after all initializers complete.
+// Nil runnables are ignored; typed nil pointers, and a pointer that is already hosted, make Run fail
+// before any initializer executes.
 func (a *App) Host(runnable ...Runnable) *App {
 	for _, r := range runnable {
-		if r == nil {
-			continue
+		a.host(r, nil, false)
+	}
+	return a
+}
+
+// HostIsolated adds runnables whose failures do not stop the app (fluent method).
+// When an isolated runnable returns an error or panics, the failure is logged and the runnable is
+// restarted after a short delay on the same instance, without cancelling the other runnables.
+// An isolated runnable that returns nil is not restarted. Isolated runnables still stop when the
+// app shuts down, and their errors are never returned by Run.
+func (a *App) HostIsolated(runnable ...Runnable) *App {
+	for _, r := range runnable {
+		a.host(r, nil, true)
+	}
+	return a
+}
+
+// HostResolved hosts every dependency registered for type T, named and unnamed, in registration order.
+// It is a function rather than a method because methods cannot have type parameters:
+//
+//	symbiont.HostResolved[Plugin](app)
+//
+// Dependencies are resolved when the app runs, after every initializer, so runnables registered
+// by initializers are hosted too. They are wired and run like runnables passed to Host.
+func HostResolved[T Runnable](a *App) *App {
+	a.resolvedHosts = append(a.resolvedHosts, func() []Runnable {
+		resolved := depend.ResolveAll[T]()
+		runnables := make([]Runnable, 0, len(resolved))
+		for _, r := range resolved {
+			runnables = append(runnables, r)
+		}
+		return runnables
+	})
+	return a
+}
+
+// HostWithReady adds a runnable whose readiness is reported by probe (fluent method).
+// The runnable is considered ready once it has started and probe returns nil, which lets
+// runnables that need warmup signal readiness without implementing ReadyChecker.
+// The probe takes precedence over any IsReady method the runnable implements.
+// A nil probe behaves like Host.
+func (a *App) HostWithReady(r Runnable, probe func(ctx context.Context) error) *App {
+	a.host(r, probe, false)
+	return a
+}
+
+func (a *App) host(r Runnable, probe func(ctx context.Context) error, isolated bool) {
+	if r == nil {
+		return
+	}
+	if err := a.checkHostable(r); err != nil {
+		a.buildErrs = append(a.buildErrs, err)
+		return
+	}
+	var (
+		readyChecker ReadyChecker
+		executor     Runnable
+	)
+	if rc, ok := r.(ReadyChecker); ok && probe == nil {
+		readyChecker = rc
+		executor = r
+	} else {
+		rc := &defaultReadyChecker{
+			runable: r,
+			probe:   probe,
+		}
+		executor = rc
+		readyChecker = rc
+	}
+
+	a.specsMu.Lock()
+	defer a.specsMu.Unlock()
+	a.runnableSpecsList = append(a.runnableSpecsList, runnableSpecs{
+		original:     r,
+		executor:     executor,
+		readyChecker: readyChecker,
+		isolated:     isolated,
+	})
+}
+
+// hostResolved hosts the runnables of every HostResolved call, resolved from the container.
+// Invalid runnables, such as one already passed to Host, are reported like build errors.
+func (a *App) hostResolved() error {
+	buildErrs := len(a.buildErrs)
+	for _, resolve := range a.resolvedHosts {
+		for _, r := range resolve() {
+			a.host(r, nil, false)
+		}
+	}
+	if len(a.buildErrs) > buildErrs {
+		return errors.Join(a.buildErrs[buildErrs:]...)
+	}
+	return nil
+}
+
+// hostedSpecs returns a snapshot of the hosted runnables, safe to use while the app runs.
+func (a *App) hostedSpecs() []runnableSpecs {
+	a.specsMu.RLock()
+	defer a.specsMu.RUnlock()
+	return slices.Clone(a.runnableSpecsList)
+}
+
+// checkHostable rejects a typed nil runnable, or one whose pointer is already hosted. The runnables
+// composed by r, such as the steps of a Sequence, are checked the same way, so they fail the build
+// instead of panicking once the app runs.
+func (a *App) checkHostable(r Runnable) error {
+	var hosted []Runnable
+	for _, rs := range a.runnableSpecsList {
+		hosted = append(hosted, hostedComponents(rs.original)...)
+	}
+	return checkComponent(r, nil, &hosted)
+}
+
+// checkComponent checks r, composed by parent unless parent is nil, against the runnables seen so
+// far, then checks the runnables it composes.
+func checkComponent(r, parent Runnable, seen *[]Runnable) error {
+	if reflectx.IsNil(r) {
+		if parent != nil {
+			return NewError(fmt.Errorf("nil runnable composed by %T", parent), r)
 		}
-		var (
-			readyChecker ReadyChecker
-			executor     Runnable
-		)
-		if rc, ok := r.(ReadyChecker); ok {
-			readyChecker = rc
-			executor = r
-		} else {
-			rc := &defaultReadyChecker{
-				runable: r,
+		return NewError(errors.New("nil runnable passed to Host"), r)
+	}
+	if slices.ContainsFunc(*seen, func(other Runnable) bool { return samePointer(r, other) }) {
+		return NewError(errors.New("runnable passed to Host more than once"), r)
+	}
+	*seen = append(*seen, r)
+	if c, ok := r.(compositeRunnable); ok {
+		for _, inner := range c.innerRunnables() {
+			if err := checkComponent(inner, r, seen); err != nil {
+				return err
 			}
-			executor = rc
-			readyChecker = rc
 		}
+	}
+	return nil
+}
 
-		a.runnableSpecsList = append(a.runnableSpecsList, runnableSpecs{
-			original:     r,
-			executor:     executor,
-			readyChecker: readyChecker,
-		})
+// samePointer reports whether a and b are the same runnable pointer.
+// Distinct values of the same type, and runnables that are not pointers, are never considered the same.
+// Pointers to zero-size types are skipped too, since distinct allocations may share an address.
+func samePointer(a, b Runnable) bool {
+	v := reflect.ValueOf(a)
+	if v.Kind() != reflect.Pointer || v.Type().Elem().Size() == 0 {
+		return false
 	}
-	return a
+	o := reflect.ValueOf(b)
+	return o.Kind() == reflect.Pointer && o.Type() == v.Type() && o.Pointer() == v.Pointer()
 }
 
 // Run executes the app: initializes components, runs runnables concurrently, and handles graceful shutdown.
 // Blocks until completion or signal (SIGINT, SIGTERM). Returns error if any phase fails.
+// Further signals are ignored unless WithForceQuitOnSecondSignal is enabled.
 func (a *App) Run() error {
-	ctx, stop := signal.NotifyContext(
-		context.Background(),
+	ctx, stop := a.signalContext(
 		// Interrupt signal sent from terminal
 		os.Interrupt,
 		// Termination signal sent from Kubernetes or other orchestrators
@@ -100,13 +287,23 @@ func (a *App) Run() error {
 	)
 	defer stop()
 
-	return a.runWithContext(ctx)
-
+	if forceCtx, ok := ctx.Value(forceQuitKey{}).(context.Context); ok {
+		return a.runUntilForced(ctx, forceCtx)
+	}
+	return a.runWithContext(ctx, nil)
 }
 
 // RunWithContext executes the app with the provided context for cancellation control.
 func (a *App) RunWithContext(ctx context.Context) error {
-	return a.runWithContext(ctx)
+	return a.runWithContext(ctx, nil)
+}
+
+// RunWithSummary executes the app like RunWithContext and also returns a summary of the shutdown:
+// how long it took and how long each closer ran, with its error. Useful to find a closer that blocks shutdown.
+func (a *App) RunWithSummary(ctx context.Context) (ShutdownSummary, error) {
+	var summary ShutdownSummary
+	err := a.runWithContext(ctx, &summary)
+	return summary, err
 }
 
 // RunAsync executes the app asynchronously in a background goroutine.
@@ -114,65 +311,150 @@ func (a *App) RunWithContext(ctx context.Context) error {
 func (a *App) RunAsync(ctx context.Context) chan error {
 	a.errCh = make(chan error, 1)
 	go func() {
-		a.errCh <- a.runWithContext(ctx)
+		a.errCh <- a.runWithContext(ctx, nil)
 		close(a.errCh)
 	}()
 	return a.errCh
 }
 
 // runWithContext is the core orchestrator: initializes, wires dependencies, runs runnables, cleans up.
-func (a *App) runWithContext(ctx context.Context) error {
-	var closers []closerFunc
-	defer func() { combineClosers(closers)() }()
+// When summary is not nil, it is filled in with the shutdown timing and closer results.
+func (a *App) runWithContext(ctx context.Context, summary *ShutdownSummary) (runErr error) {
+	a.started.Store(true)
+	var closers []registeredCloser
+	observers := a.lifecycleObservers()
+	var shutdownStart atomic.Pointer[time.Time]
+	defer func() {
+		start := time.Now()
+		if s := shutdownStart.Load(); s != nil {
+			start = *s
+		}
+		a.appLogger().Info("shutdown started", "closers", len(closers))
+		a.events.emit(eventShutdownStarted, nil, 0, nil)
+		shutdownCtx, cancel := a.shutdownContext(ctx)
+		defer cancel()
+		results, err := runClosers(shutdownCtx, closers)
+		if err != nil {
+			a.appLogger().Error("closers failed", "error", err)
+			runErr = errors.Join(runErr, err)
+		}
+		elapsed := time.Since(start)
+		if summary != nil {
+			*summary = ShutdownSummary{Duration: elapsed, Closers: results}
+		}
+		a.appLogger().Info("shutdown completed", "duration", elapsed)
+		a.events.emit(eventShutdownCompleted, nil, elapsed, nil)
+		for _, o := range observers {
+			o.shutdownCompleted(elapsed)
+		}
+	}()
+
+	if len(a.buildErrs) > 0 {
+		err := errors.Join(a.buildErrs...)
+		a.appLogger().Error("invalid app configuration", "error", err)
+		return err
+	}
+
+	var configErrs []error
+	wire := func(target any) error {
+		wireCtx := ctx
+		if p, ok := target.(ConfigPrefixer); ok {
+			wireCtx = config.WithPrefix(ctx, p.ConfigPrefix())
+		}
+		if !a.requireConfig {
+			return wireStructFields(wireCtx, target)
+		}
+		errs, err := a.wireStructFieldsCollectingConfig(wireCtx, target)
+		configErrs = append(configErrs, errs...)
+		return err
+	}
 
 	// Initialize all initializers and collect their closers
 	for _, initializer := range a.initializers {
-		err := wireStructFields(ctx, initializer)
+		err := wire(initializer)
+		if err == nil && len(configErrs) > 0 {
+			err = errors.Join(configErrs...)
+		}
 		if err != nil {
+			a.appLogger().Error("initializer wiring failed", "component", componentName(initializer), "error", err)
+			a.events.emit(eventInitializerFailed, initializer, 0, err)
 			return err
 		}
 
-		newCtx, err := initializeSafe(ctx, initializer)
+		a.appLogger().Debug("initializer started", "component", componentName(initializer))
+		a.events.emit(eventInitializerStarted, initializer, 0, nil)
+		start := time.Now()
+		initCtx, registry := withCloserRegistry(a.componentContext(ctx, initializer))
+		_, span := a.startSpan(ctx, "Initialize", initializer)
+		newCtx, err := initializeWithTimeout(initCtx, initializer, a.initTimeout)
+		span.End(err)
+		registered := closersOf(initializer, registry.drain()...)
 		if err != nil {
+			closers = append(closers, registered...)
+			a.appLogger().Error("initializer failed", "component", componentName(initializer), "duration", time.Since(start), "error", err)
+			a.events.emit(eventInitializerFailed, initializer, time.Since(start), err)
 			return err
 		}
+		if newCtx == nil {
+			if a.strictInitContext {
+				closers = append(closers, registered...)
+				err := newPhaseError(errors.New("initializer returned a nil context without an error"), initializer, PhaseInit)
+				a.appLogger().Error("initializer failed", "component", componentName(initializer), "duration", time.Since(start), "error", err)
+				a.events.emit(eventInitializerFailed, initializer, time.Since(start), err)
+				return err
+			}
+			a.appLogger().Warn("initializer returned a nil context, keeping the previous context", "component", componentName(initializer))
+		}
+		a.appLogger().Info("initializer finished", "component", componentName(initializer), "duration", time.Since(start))
+		a.events.emit(eventInitializerFinished, initializer, time.Since(start), nil)
 		if newCtx != nil {
 			ctx = newCtx
 		}
-		if closer, ok := initializer.(Closer); ok {
-			if closer != nil {
-				closers = append(closers, closer.Close)
-			}
+		// Append the initializer's own closer first, so that in LIFO order the functions it registered run before it.
+		if closer, ok := componentCloser(initializer); ok {
+			closers = append(closers, closersOf(initializer, closer)...)
 		}
+		closers = append(closers, registered...)
 	}
 
-	// Load configuration and dependencies into all hosted runnables and collect their closers
-	for _, rs := range a.runnableSpecsList {
-		err := wireStructFields(ctx, rs.original)
-		if err != nil {
+	if len(a.resolvedHosts) > 0 {
+		if err := a.hostResolved(); err != nil {
+			a.appLogger().Error("invalid app configuration", "error", err)
 			return err
 		}
-		if closer, ok := rs.original.(Closer); ok {
-			if closer != nil {
-				closers = append(closers, closer.Close)
+		observers = a.lifecycleObservers()
+	}
+
+	// Load configuration and dependencies into all hosted runnables, and the runnables they compose, and collect their closers
+	for _, rs := range a.runnableSpecsList {
+		for _, component := range hostedComponents(rs.original) {
+			err := wire(component)
+			if err != nil {
+				a.appLogger().Error("runnable wiring failed", "component", componentName(component), "error", err)
+				a.events.emit(eventRunnableFailed, component, 0, err)
+				return err
+			}
+			if closer, ok := componentCloser(component); ok {
+				closers = append(closers, closersOf(component, closer)...)
 			}
 		}
 	}
 
 	// Wire struct fields of all registered introspectors
 	for _, i := range a.introspectors {
-		if err := wireStructFields(ctx, i); err != nil {
+		if err := wire(i); err != nil {
 			return err
 		}
 	}
 
-	report := introspection.Report{
-		Configs:      config.IntrospectConfigAccesses(),
-		Deps:         depend.GetEvents(),
-		Runners:      a.runnerInfos(),
-		Initializers: a.initializerInfos(),
+	if len(configErrs) > 0 {
+		err := errors.Join(configErrs...)
+		a.appLogger().Error("configuration wiring failed", "error", err)
+		return err
 	}
 
+	report := a.IntrospectionSnapshot()
+
 	for _, i := range a.introspectors {
 		err := introspectSafe(ctx, i, report)
 		if err != nil {
@@ -192,14 +474,30 @@ func (a *App) runWithContext(ctx context.Context) error {
 	}
 
 	// Run all hosted runnables
-	errGroup, groupCtx := errgroup.WithContext(ctx)
+	errGroup, groupCtx := errgroup.WithContext(context.WithValue(ctx, shutdownTimeoutKey{}, a.shutdownTimeout))
+	stopShutdownTimer := context.AfterFunc(groupCtx, func() {
+		now := time.Now()
+		shutdownStart.CompareAndSwap(nil, &now)
+	})
+	defer stopShutdownTimer()
+	// Without long-lived runnables, finished one-shot runnables keep the app up until ctx is done.
+	onlyOneShots := !slices.ContainsFunc(a.runnableSpecsList, func(rs runnableSpecs) bool {
+		_, ok := rs.original.(OneShot)
+		return !ok
+	})
 	for _, rs := range a.runnableSpecsList {
 		func(r runnableSpecs) {
 			errGroup.Go(func() error {
-				if err := runSafe(groupCtx, r); err != nil {
-					return err
+				var err error
+				if r.isolated {
+					a.runIsolated(groupCtx, r, observers)
+				} else {
+					err = a.runHosted(groupCtx, r, observers, true)
+				}
+				if _, ok := r.original.(OneShot); ok && err == nil && onlyOneShots {
+					<-groupCtx.Done()
 				}
-				return nil
+				return err
 			})
 		}(rs)
 	}
@@ -209,24 +507,119 @@ func (a *App) runWithContext(ctx context.Context) error {
 	return errGroup.Wait()
 }
 
-// combineClosers returns a function that invokes all closers in LIFO (reverse) order.
-// Captures the closers slice at defer time for consistent cleanup order.
-func combineClosers(closers []closerFunc) closerFunc {
-	return func() {
-		for i := len(closers) - 1; i >= 0; i-- {
-			closers[i]()
+// runHosted runs a single runnable, reporting its start and stop to the logger, event stream, and observers.
+// When traced is set, the runnable's startup is also recorded as a span.
+func (a *App) runHosted(ctx context.Context, r runnableSpecs, observers []lifecycleObserver, traced bool) error {
+	name := componentName(r.original)
+	a.appLogger().Info("runnable started", "component", name)
+	a.events.emit(eventRunnableStarted, r.original, 0, nil)
+	id := runnerID(r.original)
+	for _, o := range observers {
+		o.runnableStarted(id)
+	}
+	runCtx, endStartSpan := ctx, func(error) {}
+	if traced && a.tracer != nil {
+		var span Span
+		runCtx, span = a.startSpan(ctx, "Start", r.original)
+		endStartSpan = endWhenReady(ctx, span, r.readyChecker)
+	}
+	exit, err := runSafe(a.componentContext(runCtx, r.original), r)
+	endStartSpan(err)
+	a.exits.record(exit)
+	if err != nil {
+		a.appLogger().Error("runnable failed", "component", name, "duration", exit.Duration, "reason", exit.Reason, "error", err)
+		a.events.emitExit(eventRunnableFailed, r.original, exit, err)
+	} else {
+		a.appLogger().Info("runnable stopped", "component", name, "duration", exit.Duration, "reason", exit.Reason)
+		a.events.emitExit(eventRunnableStopped, r.original, exit, nil)
+	}
+	for _, o := range observers {
+		o.runnableStopped(id, exit, err)
+	}
+	return err
+}
+
+// runIsolated runs an isolated runnable, restarting it after each failure until it returns nil or ctx is done.
+// Only the first run is traced.
+func (a *App) runIsolated(ctx context.Context, r runnableSpecs, observers []lifecycleObserver) {
+	for first := true; ; first = false {
+		err := a.runHosted(ctx, r, observers, first)
+		if err == nil || ctx.Err() != nil {
+			return
+		}
+		a.appLogger().Warn("isolated runnable restarting", "component", componentName(r.original), "delay", isolatedRestartDelay)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(isolatedRestartDelay):
+		}
+		for _, o := range observers {
+			o.runnableRestarted(runnerID(r.original))
+		}
+	}
+}
+
+// runClosers invokes all closers from the highest priority to the lowest, in LIFO (reverse) order
+// within a priority, and returns the result of each in the order they ran.
+// Every closer runs even if an earlier one fails; their errors are joined.
+func runClosers(ctx context.Context, closers []registeredCloser) ([]CloserResult, error) {
+	ordered := slices.Clone(closers)
+	slices.Reverse(ordered)
+	slices.SortStableFunc(ordered, func(a, b registeredCloser) int {
+		return cmp.Compare(b.priority, a.priority)
+	})
+	results := make([]CloserResult, 0, len(ordered))
+	var errs []error
+	for _, c := range ordered {
+		start := time.Now()
+		err := c.fn(ctx)
+		results = append(results, CloserResult{Component: c.component, Duration: time.Since(start), Err: err})
+		if err != nil {
+			errs = append(errs, err)
 		}
 	}
+	return results, errors.Join(errs...)
+}
+
+// lifecycleObservers returns the hosted runnables that observe lifecycle events.
+func (a *App) lifecycleObservers() []lifecycleObserver {
+	var observers []lifecycleObserver
+	for _, rs := range a.hostedSpecs() {
+		if o, ok := rs.original.(lifecycleObserver); ok {
+			observers = append(observers, o)
+		}
+	}
+	return observers
+}
+
+// componentName returns the type name used to identify a component in logs and metrics.
+func componentName(component any) string {
+	return reflectx.GetTypeName(reflect.TypeOf(component))
+}
+
+// runnerID returns the ID a runnable is reported under: its type name, followed by its instance
+// name when it implements Named, so that runnables of the same type are told apart in metrics.
+func runnerID(runnable any) string {
+	info := introspection.RunnerInfo{Type: componentName(runnable)}
+	if n, ok := runnable.(Named); ok {
+		info.Name = n.Name()
+	}
+	return info.ID()
 }
 
 func (a *App) runnerInfos() []introspection.RunnerInfo {
-	rInfos := make([]introspection.RunnerInfo, 0, len(a.runnableSpecsList))
-	for _, rs := range a.runnableSpecsList {
+	specs := a.hostedSpecs()
+	rInfos := make([]introspection.RunnerInfo, 0, len(specs))
+	for _, rs := range specs {
 		t := reflect.TypeOf(rs.original)
-		rInfos = append(rInfos, introspection.RunnerInfo{
+		info := introspection.RunnerInfo{
 			Type:      reflectx.GetTypeName(t),
 			Component: t,
-		})
+		}
+		if n, ok := rs.original.(Named); ok {
+			info.Name = n.Name()
+		}
+		rInfos = append(rInfos, info)
 	}
 	return rInfos
 }
@@ -248,42 +641,123 @@ func (a *App) initializerInfos() []introspection.InitializerInfo {
 func initializeSafe(ctx context.Context, init Initializer) (newCtx context.Context, err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			err = NewError(fmt.Errorf("panic in Initialize func: %v", r), init)
+			err = newPhaseError(fmt.Errorf("panic in Initialize func: %v", r), init, PhaseInit)
 		}
 	}()
 	newCtx, err = init.Initialize(ctx)
 	if err != nil {
-		err = NewError(err, init)
+		err = newPhaseError(err, init, PhaseInit)
 	}
 	return newCtx, err
 }
 
+// initializeWithTimeout calls initializeSafe under a deadline when timeout > 0.
+// The returned context keeps the values added by the initializer but takes its cancellation
+// from ctx, so the init deadline does not leak into later components.
+func initializeWithTimeout(ctx context.Context, init Initializer, timeout time.Duration) (context.Context, error) {
+	if timeout <= 0 {
+		return initializeSafe(ctx, init)
+	}
+	initCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	type initResult struct {
+		ctx context.Context
+		err error
+	}
+	resultCh := make(chan initResult, 1)
+	go func() {
+		newCtx, err := initializeSafe(initCtx, init)
+		resultCh <- initResult{ctx: newCtx, err: err}
+	}()
+
+	select {
+	case res := <-resultCh:
+		if res.err != nil {
+			if ctx.Err() == nil && errors.Is(initCtx.Err(), context.DeadlineExceeded) {
+				return nil, newPhaseError(NewInitTimeoutError(init, timeout), init, PhaseInit)
+			}
+			return nil, res.err
+		}
+		if res.ctx == nil {
+			return nil, nil
+		}
+		if res.ctx == initCtx {
+			return ctx, nil
+		}
+		return valuesContext{Context: ctx, values: res.ctx}, nil
+	case <-initCtx.Done():
+		if ctx.Err() != nil {
+			return nil, newPhaseError(ctx.Err(), init, PhaseInit)
+		}
+		return nil, newPhaseError(NewInitTimeoutError(init, timeout), init, PhaseInit)
+	}
+}
+
+// valuesContext resolves values from one context while taking deadline and cancellation from another.
+type valuesContext struct {
+	context.Context
+	values context.Context
+}
+
+// Value looks up key in the values context.
+func (c valuesContext) Value(key any) any {
+	return c.values.Value(key)
+}
+
 // runSafe calls a runnable's Run method with panic recovery.
-// Wraps both panics and errors in NewError for debugging.
-func runSafe(ctx context.Context, rs runnableSpecs) (err error) {
+// Wraps both panics and errors in NewError for debugging, and describes how long Run took
+// and why it returned. The exit is classified before wrapping, as Error does not unwrap.
+func runSafe(ctx context.Context, rs runnableSpecs) (exit introspection.RunnableExitEvent, err error) {
+	start := time.Now()
 	defer func() {
 		if r := recover(); r != nil {
-			err = NewError(fmt.Errorf("panic in Run func: %v", r), rs.original)
+			err = fmt.Errorf("panic in Run func: %v", r)
+		}
+		exit = runnableExit(ctx, rs.original, time.Since(start), err)
+		if err != nil {
+			err = newPhaseError(err, rs.original, PhaseRun)
 		}
 	}()
-	err = rs.executor.Run(ctx)
-	if err != nil {
-		err = NewError(err, rs.original)
-	}
-	return err
+	return exit, rs.executor.Run(ctx)
 }
 
-// wireStructFields injects dependencies and configuration into struct fields via tags.
-// Resolves resolve:"name" tags for dependencies and config:"key" tags for configuration.
+// wireStructFields injects dependencies, configuration, and context values into struct fields via tags.
+// Resolves resolve:"name" tags for dependencies, config:"key" tags for configuration, and
+// context:"name" tags for values stored in ctx under a ContextKey.
 func wireStructFields(ctx context.Context, target any) error {
 	err := reflectx.IterateStructFields(
 		target,
-		depend.ResolveStructFieldValue,
+		resolveFieldValue,
 		config.LoadStructFieldValue(ctx),
+		contextFieldValue(ctx),
+		appContextFieldValue(ctx),
 	)
 
 	if err != nil {
-		return NewError(err, target)
+		return newPhaseError(err, target, PhaseWiring)
 	}
 	return nil
 }
+
+// wireStructFieldsCollectingConfig wires a target like wireStructFields, but keeps going past config failures.
+// Config failures are returned per field, each wrapped with the target; other failures are returned as err.
+func (a *App) wireStructFieldsCollectingConfig(ctx context.Context, target any) (configErrs []error, err error) {
+	loadConfig := config.LoadStructFieldValue(ctx)
+	err = reflectx.IterateStructFields(
+		target,
+		resolveFieldValue,
+		func(fieldValue reflect.Value, structField reflect.StructField, targetType reflect.Type) error {
+			if err := loadConfig(fieldValue, structField, targetType); err != nil {
+				configErrs = append(configErrs, newPhaseError(err, target, PhaseWiring))
+			}
+			return nil
+		},
+		contextFieldValue(ctx),
+		appContextFieldValue(ctx),
+	)
+	if err != nil {
+		return configErrs, newPhaseError(err, target, PhaseWiring)
+	}
+	return configErrs, nil
+}
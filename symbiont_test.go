@@ -3,13 +3,19 @@ package symbiont
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"reflect"
+	"slices"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/cleitonmarx/symbiont/config"
+	"github.com/cleitonmarx/symbiont/config/configtest"
 	"github.com/cleitonmarx/symbiont/depend"
 )
 
@@ -98,21 +104,11 @@ func (r *resolveDepRun) Run(ctx context.Context) error {
 	return nil
 }
 
-// simple provider for config tests
-type simpleProvider struct{ key, val string }
-
-func (s *simpleProvider) Get(ctx context.Context, name string) (string, error) {
-	if name == s.key {
-		return s.val, nil
-	}
-	return "", errors.New("not found")
-}
-
 // initializer that sets the global provider
 type setProviderInitializer struct{ key, val string }
 
 func (s *setProviderInitializer) Initialize(ctx context.Context) (context.Context, error) {
-	config.SetGlobalProvider(&simpleProvider{key: s.key, val: s.val})
+	config.SetGlobalProvider(configtest.NewMapProvider(map[string]string{s.key: s.val}))
 	return ctx, nil
 }
 
@@ -135,6 +131,7 @@ func TestApp_RunWithContext(t *testing.T) {
 		inits []Initializer
 		runs  []Runnable
 	}
+	dupRunnable := &runCloser{name: "dup"}
 
 	tests := map[string]struct {
 		inits     []Initializer
@@ -306,6 +303,43 @@ func TestApp_RunWithContext(t *testing.T) {
 				}
 			},
 		},
+		"typed-nil-runnable-fails-before-initializers-run": {
+			inits: []Initializer{&recCloser{name: "init"}},
+			runs:  []Runnable{(*runCloser)(nil)},
+			validate: func(t *testing.T, _ *testCase, err error) {
+				want := "error: nil runnable passed to Host, component: *symbiont.runCloser"
+				if err == nil || err.Error() != want {
+					t.Fatalf("expected error %q, got %v", want, err)
+				}
+			},
+		},
+		"typed-nil-initializer-fails-before-initializers-run": {
+			inits: []Initializer{&panicInitializer{}, (*recCloser)(nil)},
+			validate: func(t *testing.T, _ *testCase, err error) {
+				want := "error: nil initializer passed to Initialize, component: *symbiont.recCloser"
+				if err == nil || err.Error() != want {
+					t.Fatalf("expected error %q, got %v", want, err)
+				}
+			},
+		},
+		"same-runnable-pointer-hosted-twice-fails-before-initializers-run": {
+			inits: []Initializer{&panicInitializer{}},
+			runs:  []Runnable{dupRunnable, &runCloser{name: "other"}, dupRunnable},
+			validate: func(t *testing.T, _ *testCase, err error) {
+				want := "error: runnable passed to Host more than once, component: *symbiont.runCloser"
+				if err == nil || err.Error() != want {
+					t.Fatalf("expected error %q, got %v", want, err)
+				}
+			},
+		},
+		"distinct-runnables-of-same-type-are-allowed": {
+			runs: []Runnable{&runCloser{name: "a"}, &runCloser{name: "b"}},
+			validate: func(t *testing.T, _ *testCase, err error) {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+			},
+		},
 		"nil-initializer-and-nil-runnable-are-ignored": {
 			inits: []Initializer{nil},
 			runs:  []Runnable{nil},
@@ -326,13 +360,13 @@ func TestApp_RunWithContext(t *testing.T) {
 			// Setup: attach shared close log to all closers
 			closeLog := []string{}
 			for i, in := range test.inits {
-				if rc, ok := in.(*recCloser); ok {
+				if rc, ok := in.(*recCloser); ok && rc != nil {
 					rc.log = &closeLog
 					test.inits[i] = rc
 				}
 			}
 			for i, r := range test.runs {
-				if rc, ok := r.(*runCloser); ok {
+				if rc, ok := r.(*runCloser); ok && rc != nil {
 					rc.log = &closeLog
 					test.runs[i] = rc
 				}
@@ -430,3 +464,679 @@ func TestApp_RunAsync_ContextCancel(t *testing.T) {
 		t.Fatal("waitRunnable did not stop after context cancel")
 	}
 }
+
+// sleepInitializer blocks for a duration, optionally honoring context cancellation.
+type sleepInitializer struct {
+	sleep       time.Duration
+	cooperative bool
+}
+
+func (s *sleepInitializer) Initialize(ctx context.Context) (context.Context, error) {
+	if s.cooperative {
+		select {
+		case <-ctx.Done():
+			return ctx, ctx.Err()
+		case <-time.After(s.sleep):
+		}
+		return ctx, nil
+	}
+	time.Sleep(s.sleep)
+	return context.WithValue(ctx, testContextKey, "slow"), nil
+}
+
+// ctxAliveRunnable records whether its context outlives the init timeout.
+type ctxAliveRunnable struct {
+	wait   time.Duration
+	gotErr error
+	gotVal any
+}
+
+func (c *ctxAliveRunnable) Run(ctx context.Context) error {
+	time.Sleep(c.wait)
+	c.gotErr = ctx.Err()
+	c.gotVal = ctx.Value(testContextKey)
+	return nil
+}
+
+func TestApp_WithInitTimeout(t *testing.T) {
+	tests := map[string]struct {
+		timeout  time.Duration
+		init     Initializer
+		run      *ctxAliveRunnable
+		validate func(t *testing.T, run *ctxAliveRunnable, err error, elapsed time.Duration)
+	}{
+		"cooperative-initializer-times-out": {
+			timeout: 20 * time.Millisecond,
+			init:    &sleepInitializer{sleep: time.Second, cooperative: true},
+			validate: func(t *testing.T, _ *ctxAliveRunnable, err error, _ time.Duration) {
+				var te InitTimeoutError
+				if !errors.As(err, &te) {
+					t.Fatalf("expected InitTimeoutError, got %T: %v", err, err)
+				}
+				if te.ComponentName != "*symbiont.sleepInitializer" {
+					t.Fatalf("expected component %q, got %q", "*symbiont.sleepInitializer", te.ComponentName)
+				}
+				if !errors.Is(err, context.DeadlineExceeded) {
+					t.Fatalf("expected error to match context.DeadlineExceeded")
+				}
+				var se Error
+				if !errors.As(err, &se) || se.Phase != PhaseInit {
+					t.Fatalf("expected an Error with phase %q, got %T: %v", PhaseInit, err, err)
+				}
+			},
+		},
+		"non-cooperative-initializer-times-out": {
+			timeout: 20 * time.Millisecond,
+			init:    &sleepInitializer{sleep: 300 * time.Millisecond},
+			validate: func(t *testing.T, _ *ctxAliveRunnable, err error, elapsed time.Duration) {
+				want := "error: initializer timed out after 20ms, component: *symbiont.sleepInitializer"
+				if err == nil || err.Error() != want {
+					t.Fatalf("expected error %q, got %v", want, err)
+				}
+				if elapsed >= 300*time.Millisecond {
+					t.Fatalf("expected Run to return before the initializer finished, took %s", elapsed)
+				}
+			},
+		},
+		"returned-context-outlives-timeout": {
+			timeout: 30 * time.Millisecond,
+			init:    &sleepInitializer{sleep: time.Millisecond},
+			run:     &ctxAliveRunnable{wait: 60 * time.Millisecond},
+			validate: func(t *testing.T, run *ctxAliveRunnable, err error, _ time.Duration) {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				if run.gotErr != nil {
+					t.Fatalf("expected runnable context to stay alive, got %v", run.gotErr)
+				}
+				if run.gotVal != "slow" {
+					t.Fatalf("expected context value %q, got %v", "slow", run.gotVal)
+				}
+			},
+		},
+		"no-timeout-by-default": {
+			init: &sleepInitializer{sleep: 30 * time.Millisecond},
+			validate: func(t *testing.T, _ *ctxAliveRunnable, err error, _ time.Duration) {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			depend.ClearContainer()
+			config.ResetGlobalProvider()
+			defer func() { depend.ClearContainer(); config.ResetGlobalProvider() }()
+
+			a := NewApp().WithInitTimeout(tt.timeout).Initialize(tt.init)
+			if tt.run != nil {
+				a.Host(tt.run)
+			}
+			start := time.Now()
+			err := a.RunWithContext(context.Background())
+			tt.validate(t, tt.run, err, time.Since(start))
+		})
+	}
+}
+
+// multiConfigRun declares several required config fields.
+type multiConfigRun struct {
+	Host    string `config:"HOST"`
+	Port    int    `config:"PORT"`
+	Timeout string `config:"TIMEOUT" default:"1s"`
+	ran     bool
+}
+
+func (m *multiConfigRun) Run(context.Context) error { m.ran = true; return nil }
+
+// multiConfigInit declares several required config fields.
+type multiConfigInit struct {
+	User     string `config:"USER_NAME"`
+	Password string `config:"PASSWORD"`
+	ran      bool
+}
+
+func (m *multiConfigInit) Initialize(ctx context.Context) (context.Context, error) {
+	m.ran = true
+	return ctx, nil
+}
+
+func TestApp_RequireConfig(t *testing.T) {
+	tests := map[string]struct {
+		values        map[string]string
+		require       bool
+		init          *multiConfigInit
+		runs          []*multiConfigRun
+		wantErrs      []string
+		wantNotInErr  []string
+		wantInitRan   bool
+		wantRunnerRan bool
+	}{
+		"reports-all-missing-runnable-keys": {
+			values:  map[string]string{"USER_NAME": "u", "PASSWORD": "p"},
+			require: true,
+			init:    &multiConfigInit{},
+			runs:    []*multiConfigRun{{}, {}},
+			wantErrs: []string{
+				"error getting value for field 'Host'",
+				"error getting value for field 'Port'",
+			},
+			wantNotInErr: []string{"Timeout"},
+			wantInitRan:  true,
+		},
+		"reports-all-missing-initializer-keys": {
+			values:  map[string]string{"HOST": "h", "PORT": "1"},
+			require: true,
+			init:    &multiConfigInit{},
+			runs:    []*multiConfigRun{{}},
+			wantErrs: []string{
+				"error getting value for field 'User'",
+				"error getting value for field 'Password'",
+			},
+		},
+		"reports-parse-errors": {
+			values:      map[string]string{"USER_NAME": "u", "PASSWORD": "p", "HOST": "h", "PORT": "not-a-number"},
+			require:     true,
+			init:        &multiConfigInit{},
+			runs:        []*multiConfigRun{{}},
+			wantErrs:    []string{"error parsing value for field 'Port'"},
+			wantInitRan: true,
+		},
+		"all-config-present": {
+			values:        map[string]string{"USER_NAME": "u", "PASSWORD": "p", "HOST": "h", "PORT": "1"},
+			require:       true,
+			init:          &multiConfigInit{},
+			runs:          []*multiConfigRun{{}},
+			wantInitRan:   true,
+			wantRunnerRan: true,
+		},
+		"without-require-config-fails-on-first-key": {
+			values:       map[string]string{"USER_NAME": "u", "PASSWORD": "p"},
+			init:         &multiConfigInit{},
+			runs:         []*multiConfigRun{{}},
+			wantErrs:     []string{"error getting value for field 'Host'"},
+			wantNotInErr: []string{"Port"},
+			wantInitRan:  true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			depend.ClearContainer()
+			config.ResetGlobalProvider()
+			defer func() { depend.ClearContainer(); config.ResetGlobalProvider() }()
+			config.SetGlobalProvider(configtest.NewMapProvider(tt.values))
+
+			intro := &recorderIntrospector{}
+			a := NewApp().Initialize(tt.init).Introspect(intro)
+			for _, r := range tt.runs {
+				a.Host(r)
+			}
+			if tt.require {
+				a.RequireConfig()
+			}
+
+			err := a.RunWithContext(context.Background())
+			if len(tt.wantErrs) == 0 && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if len(tt.wantErrs) > 0 && err == nil {
+				t.Fatalf("expected errors %v, got nil", tt.wantErrs)
+			}
+			for _, want := range tt.wantErrs {
+				if !strings.Contains(err.Error(), want) {
+					t.Fatalf("expected error to contain %q, got %q", want, err.Error())
+				}
+			}
+			for _, notWant := range tt.wantNotInErr {
+				if strings.Contains(err.Error(), notWant) {
+					t.Fatalf("expected error not to contain %q, got %q", notWant, err.Error())
+				}
+			}
+			if tt.init.ran != tt.wantInitRan {
+				t.Fatalf("expected initializer ran=%v, got %v", tt.wantInitRan, tt.init.ran)
+			}
+			for _, r := range tt.runs {
+				if r.ran != tt.wantRunnerRan {
+					t.Fatalf("expected runnable ran=%v, got %v", tt.wantRunnerRan, r.ran)
+				}
+			}
+			for _, access := range intro.report.Configs {
+				if access.Caller.Func != "" {
+					t.Fatalf("expected wiring accesses to have no caller func, got %q", access.Caller.Func)
+				}
+			}
+		})
+	}
+}
+
+func TestApp_ErrorPhase(t *testing.T) {
+	tests := map[string]struct {
+		app       func() *App
+		wantPhase Phase
+	}{
+		"initializer-error": {
+			app:       func() *App { return NewApp().Initialize(&errInitializer{}) },
+			wantPhase: PhaseInit,
+		},
+		"initializer-panic": {
+			app:       func() *App { return NewApp().Initialize(&panicInitializer{}) },
+			wantPhase: PhaseInit,
+		},
+		"wiring-error": {
+			app:       func() *App { return NewApp().Host(&resolveDepRun{}) },
+			wantPhase: PhaseWiring,
+		},
+		"introspector-error": {
+			app: func() *App {
+				return NewApp().Initialize(&initForIntrospect{}).Host(&runForIntrospect{}).Introspect(&recorderIntrospector{willErr: true})
+			},
+			wantPhase: PhaseIntrospect,
+		},
+		"runnable-error": {
+			app:       func() *App { return NewApp().Host(&runCloser{log: &[]string{}, willErr: true}) },
+			wantPhase: PhaseRun,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			depend.ClearContainer()
+			config.ResetGlobalProvider()
+			defer func() { depend.ClearContainer(); config.ResetGlobalProvider() }()
+			config.SetGlobalProvider(configtest.NewMapProvider(map[string]string{"cfgKey": "val"}))
+
+			err := tt.app().RunWithContext(context.Background())
+			var se Error
+			if !errors.As(err, &se) {
+				t.Fatalf("expected symbiont.Error, got %T: %v", err, err)
+			}
+			if se.Phase != tt.wantPhase {
+				t.Fatalf("expected phase %q, got %q", tt.wantPhase, se.Phase)
+			}
+			if strings.Contains(se.Error(), string(tt.wantPhase)+":") {
+				t.Fatalf("expected phase to stay out of the message, got %q", se.Error())
+			}
+		})
+	}
+}
+
+// prefixedServer reads its port under a per-instance config prefix.
+type prefixedServer struct {
+	prefix string
+	Port   int `config:"HTTP_PORT"`
+}
+
+func (s *prefixedServer) ConfigPrefix() string          { return s.prefix }
+func (s *prefixedServer) Run(ctx context.Context) error { return nil }
+
+func TestApp_ConfigPrefixer(t *testing.T) {
+	depend.ClearContainer()
+	config.ResetGlobalProvider()
+	defer func() { depend.ClearContainer(); config.ResetGlobalProvider() }()
+	config.SetGlobalProvider(configtest.NewMapProvider(map[string]string{
+		"HTTP_PORT":       "8080",
+		"ADMIN_HTTP_PORT": "9090",
+	}))
+
+	public := &prefixedServer{}
+	admin := &prefixedServer{prefix: "ADMIN_"}
+	intro := &recorderIntrospector{}
+	err := NewApp().Host(public, admin).Introspect(intro).RunWithContext(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if public.Port != 8080 || admin.Port != 9090 {
+		t.Fatalf("expected ports 8080 and 9090, got %d and %d", public.Port, admin.Port)
+	}
+
+	var keys []string
+	for _, access := range intro.report.Configs {
+		keys = append(keys, access.Key)
+	}
+	if !slices.Contains(keys, "ADMIN_HTTP_PORT") {
+		t.Fatalf("expected report to record the prefixed key, got %v", keys)
+	}
+}
+
+// flakyRunnable panics on its first run, fails on the following runs until failures is reached, then succeeds.
+type flakyRunnable struct {
+	failures int
+	runs     atomic.Int32
+}
+
+func (f *flakyRunnable) Run(context.Context) error {
+	n := int(f.runs.Add(1))
+	if n == 1 {
+		panic("first run")
+	}
+	if n <= f.failures {
+		return errors.New("flaky")
+	}
+	return nil
+}
+
+func TestApp_HostIsolated(t *testing.T) {
+	defer func(d time.Duration) { isolatedRestartDelay = d }(isolatedRestartDelay)
+	isolatedRestartDelay = time.Millisecond
+
+	tests := map[string]struct {
+		flaky    *flakyRunnable
+		timeout  time.Duration
+		wantRuns func(n int32) bool
+	}{
+		"restarts-until-success": {
+			flaky:    &flakyRunnable{failures: 3},
+			timeout:  100 * time.Millisecond,
+			wantRuns: func(n int32) bool { return n == 4 },
+		},
+		"keeps-restarting-until-shutdown": {
+			flaky:    &flakyRunnable{failures: 1 << 30},
+			timeout:  50 * time.Millisecond,
+			wantRuns: func(n int32) bool { return n > 2 },
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			depend.ClearContainer()
+			ctx, cancel := context.WithTimeout(context.Background(), tt.timeout)
+			defer cancel()
+
+			sibling := &waitRunnable{done: make(chan struct{})}
+			metrics := MetricsRunnable("127.0.0.1:0")
+			err := NewApp().Host(sibling, metrics).HostIsolated(tt.flaky).RunWithContext(ctx)
+			if err != nil {
+				t.Fatalf("expected isolated failures not to be returned, got %v", err)
+			}
+			runs := tt.flaky.runs.Load()
+			if !tt.wantRuns(runs) {
+				t.Fatalf("unexpected number of runs: %d", runs)
+			}
+
+			rec := httptest.NewRecorder()
+			metrics.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+			wantRestarts := fmt.Sprintf(`symbiont_runnable_restarts_total{runnable="*symbiont.flakyRunnable"} %d`, runs-1)
+			if !strings.Contains(rec.Body.String(), wantRestarts) {
+				t.Fatalf("expected metrics to contain %q, got:\n%s", wantRestarts, rec.Body.String())
+			}
+			if ctx.Err() == nil {
+				t.Fatal("expected the sibling to run until the context was done")
+			}
+		})
+	}
+}
+
+func TestServers_RunAgainAfterFailure(t *testing.T) {
+	tests := map[string]struct {
+		server  Runnable
+		wantErr string
+	}{
+		"http-server": {
+			server:  HTTPRunnable(&http.Server{Addr: "invalid-address"}),
+			wantErr: "symbiont: http server: ",
+		},
+		"metrics-server": {
+			server:  MetricsRunnable("invalid-address"),
+			wantErr: "metrics: ",
+		},
+		"pprof-server": {
+			server:  PprofRunnable("invalid-address"),
+			wantErr: "pprof: ",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			for range 2 {
+				err := tt.server.Run(context.Background())
+				if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) || strings.Contains(err.Error(), "already running") {
+					t.Fatalf("expected listen error with prefix %q, got %v", tt.wantErr, err)
+				}
+			}
+		})
+	}
+}
+
+func TestHostResolved(t *testing.T) {
+	depend.ClearContainer()
+	defer depend.ClearContainer()
+
+	var log []string
+	depend.RegisterNamed[Runnable](&runCloser{name: "first", log: &log}, "first")
+	depend.Register[Runnable](&runCloser{name: "second", log: &log})
+	depend.RegisterNamed[Runnable](&runCloser{name: "third", log: &log}, "third")
+	depend.Register("not a runnable")
+
+	a := HostResolved[Runnable](NewApp())
+	if len(a.runnableSpecsList) != 0 {
+		t.Fatalf("expected resolution to wait for Run, got %d hosted runnables", len(a.runnableSpecsList))
+	}
+	if err := a.RunWithContext(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(a.runnableSpecsList) != 3 {
+		t.Fatalf("expected 3 hosted runnables, got %d", len(a.runnableSpecsList))
+	}
+	// Closers run in reverse hosting order, which reveals the registration order.
+	if want := []string{"third", "second", "first"}; !slices.Equal(log, want) {
+		t.Fatalf("expected close order %v, got %v", want, log)
+	}
+}
+
+// pluginInitializer registers a runnable plugin in the container while the app initializes.
+type pluginInitializer struct{ plugin Runnable }
+
+func (p *pluginInitializer) Initialize(ctx context.Context) (context.Context, error) {
+	depend.RegisterNamed(p.plugin, "plugin")
+	return ctx, nil
+}
+
+func TestHostResolved_RegisteredByInitializer(t *testing.T) {
+	depend.ClearContainer()
+	defer depend.ClearContainer()
+
+	tests := map[string]struct {
+		hostDirectly bool
+		wantErr      string
+	}{
+		"hosts-plugin": {},
+		"plugin-also-hosted-directly": {
+			hostDirectly: true,
+			wantErr:      "runnable passed to Host more than once",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			depend.ClearContainer()
+			var log []string
+			plugin := &runCloser{name: "plugin", log: &log}
+			intro := &recorderIntrospector{}
+			a := NewApp().Initialize(&pluginInitializer{plugin: plugin}).Introspect(intro)
+			if tt.hostDirectly {
+				a.Host(plugin)
+			}
+			a = HostResolved[Runnable](a)
+
+			err := a.RunWithContext(context.Background())
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if !slices.Equal(log, []string{"plugin"}) {
+				t.Fatalf("expected the plugin to run and close, got %v", log)
+			}
+			if len(intro.report.Runners) != 1 || intro.report.Runners[0].Type != "*symbiont.runCloser" {
+				t.Fatalf("expected the plugin in the introspection report, got %+v", intro.report.Runners)
+			}
+		})
+	}
+}
+
+func TestHostResolved_WaitForReadiness(t *testing.T) {
+	depend.ClearContainer()
+	defer depend.ClearContainer()
+
+	plugin := &waitRunnable{done: make(chan struct{})}
+	a := HostResolved[Runnable](NewApp().Initialize(&pluginInitializer{plugin: plugin}))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errCh := a.RunAsync(ctx)
+
+	statuses, err := a.WaitForReadinessDetailed(ctx, time.Second)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if status, ok := statuses["*symbiont.waitRunnable"]; !ok || status != nil {
+		t.Fatalf("expected the resolved plugin to be ready, got %v", statuses)
+	}
+	cancel()
+	if err := <-errCh; err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+// migration is a one-shot runnable that closes done when it returns.
+type migration struct {
+	done    chan struct{}
+	willErr bool
+}
+
+func (m *migration) OneShot() {}
+
+func (m *migration) Run(context.Context) error {
+	defer close(m.done)
+	if m.willErr {
+		return errors.New("migration failed")
+	}
+	return nil
+}
+
+// returningRunnable is a long-lived runnable that returns immediately.
+type returningRunnable struct{}
+
+func (*returningRunnable) Run(context.Context) error { return nil }
+
+func TestApp_OneShot(t *testing.T) {
+	tests := map[string]struct {
+		runnables  func(m *migration) []Runnable
+		willErr    bool
+		wantStayUp bool
+		wantErr    string
+	}{
+		"only-one-shots-stay-up-until-cancel": {
+			runnables:  func(m *migration) []Runnable { return []Runnable{m} },
+			wantStayUp: true,
+		},
+		"one-shot-error-stops-app": {
+			runnables: func(m *migration) []Runnable { return []Runnable{m} },
+			willErr:   true,
+			wantErr:   "migration failed",
+		},
+		"long-lived-runnables-decide-lifetime": {
+			runnables: func(m *migration) []Runnable { return []Runnable{m, &returningRunnable{}} },
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			depend.ClearContainer()
+			defer depend.ClearContainer()
+
+			m := &migration{done: make(chan struct{}), willErr: tt.willErr}
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			errCh := NewApp().Host(tt.runnables(m)...).RunAsync(ctx)
+
+			<-m.done
+			if tt.wantStayUp {
+				select {
+				case err := <-errCh:
+					t.Fatalf("expected app to keep running after the one-shot returned, got %v", err)
+				case <-time.After(50 * time.Millisecond):
+				}
+				cancel()
+			}
+
+			select {
+			case err := <-errCh:
+				if tt.wantErr == "" && err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+			case <-time.After(time.Second):
+				t.Fatal("app did not stop")
+			}
+		})
+	}
+}
+
+// nilCtxInitializer returns a nil context without an error.
+type nilCtxInitializer struct{}
+
+func (nilCtxInitializer) Initialize(context.Context) (context.Context, error) {
+	return nil, nil
+}
+
+func TestApp_WithStrictInitContext(t *testing.T) {
+	tests := map[string]struct {
+		strict       bool
+		wantErr      string
+		wantMessages []string
+	}{
+		"warns-by-default": {
+			wantMessages: []string{
+				"DEBUG initializer started",
+				"WARN initializer returned a nil context, keeping the previous context",
+				"INFO initializer finished",
+				"DEBUG initializer started",
+				"INFO initializer finished",
+				"INFO shutdown started",
+				"INFO shutdown completed",
+			},
+		},
+		"strict-fails-startup": {
+			strict:  true,
+			wantErr: "error: initializer returned a nil context without an error, component: *symbiont.nilCtxInitializer",
+			wantMessages: []string{
+				"DEBUG initializer started",
+				"ERROR initializer failed",
+				"INFO shutdown started",
+				"INFO shutdown completed",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			depend.ClearContainer()
+			logger := &recordingLogger{}
+			err := NewApp().
+				WithLogger(logger).
+				WithStrictInitContext(tt.strict).
+				Initialize(&nilCtxInitializer{}, &ctxInitializer{key: testContextKey, val: "v"}).
+				RunWithContext(context.Background())
+
+			gotErr := ""
+			if err != nil {
+				gotErr = err.Error()
+			}
+			if gotErr != tt.wantErr {
+				t.Fatalf("expected error %q, got %q", tt.wantErr, gotErr)
+			}
+			if got := logger.messages(); !slices.Equal(tt.wantMessages, got) {
+				t.Fatalf("expected messages %v, got %v", tt.wantMessages, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+// Package symbionttest runs symbiont apps in tests, replacing the goroutine and channel plumbing
+// each integration test would otherwise repeat:
+//
+//	func TestServer(t *testing.T) {
+//		symbionttest.Isolate(t)
+//		h := symbionttest.Run(t, symbiont.NewApp().Initialize(&InitDB{}).Host(server))
+//		// exercise the running app
+//		h.Stop()
+//	}
+package symbionttest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont"
+	"github.com/cleitonmarx/symbiont/config"
+	"github.com/cleitonmarx/symbiont/depend"
+)
+
+// DefaultTimeout bounds both the wait for readiness and the wait for shutdown in Run.
+const DefaultTimeout = 5 * time.Second
+
+// Harness is a running app started by Run.
+type Harness struct {
+	t       testing.TB
+	app     *symbiont.App
+	timeout time.Duration
+	cancel  context.CancelFunc
+	errCh   chan error
+	done    bool
+	err     error
+}
+
+// Run starts app in the background and waits until all its runnables are ready, failing the test
+// if the app stops or does not become ready within DefaultTimeout. The app is stopped when the test
+// ends if Stop or Wait was not called.
+func Run(t testing.TB, app *symbiont.App) *Harness {
+	t.Helper()
+	return RunWithTimeout(t, app, DefaultTimeout)
+}
+
+// RunWithTimeout is like Run with a custom timeout for readiness and shutdown.
+func RunWithTimeout(t testing.TB, app *symbiont.App, timeout time.Duration) *Harness {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	h := &Harness{t: t, app: app, timeout: timeout, cancel: cancel, errCh: app.RunAsync(ctx)}
+	t.Cleanup(func() {
+		if !h.done {
+			h.Stop()
+		}
+	})
+
+	readyCh := make(chan error, 1)
+	go func() {
+		readyCh <- app.WaitForReadiness(ctx, timeout)
+	}()
+	select {
+	case err := <-readyCh:
+		if err != nil {
+			t.Fatalf("symbionttest: app did not become ready: %v", err)
+		}
+	case err := <-h.errCh:
+		h.done, h.err = true, err
+		t.Fatalf("symbionttest: app stopped before becoming ready: %v", err)
+	}
+	return h
+}
+
+// App returns the running app.
+func (h *Harness) App() *symbiont.App {
+	return h.app
+}
+
+// Stop cancels the app's context and fails the test unless the app shuts down without error
+// within the timeout. Calling Stop after the app has stopped has no effect.
+func (h *Harness) Stop() {
+	h.t.Helper()
+	if h.done {
+		return
+	}
+	h.cancel()
+	if err := h.wait(); err != nil {
+		h.t.Fatalf("symbionttest: app did not shut down cleanly: %v", err)
+	}
+}
+
+// Wait waits for the app to stop on its own, such as after a runnable fails, and returns the error
+// it stopped with. The test fails if the app does not stop within the timeout.
+func (h *Harness) Wait() error {
+	h.t.Helper()
+	if h.done {
+		return h.err
+	}
+	select {
+	case err := <-h.errCh:
+		h.done, h.err = true, err
+		return err
+	case <-time.After(h.timeout):
+		h.cancel()
+		h.t.Fatalf("symbionttest: app did not stop within %s", h.timeout)
+		return nil
+	}
+}
+
+// wait waits for the cancelled app to return, failing the test on timeout.
+func (h *Harness) wait() error {
+	h.t.Helper()
+	select {
+	case err := <-h.errCh:
+		h.done, h.err = true, err
+		return err
+	case <-time.After(h.timeout):
+		h.done = true
+		h.t.Fatalf("symbionttest: app did not shut down within %s", h.timeout)
+		return nil
+	}
+}
+
+// Isolate clears the dependency container and resets the global config provider, now and when
+// the test ends, so tests running apps do not see each other's registrations.
+func Isolate(t testing.TB) {
+	t.Helper()
+	reset := func() {
+		depend.ClearContainer()
+		config.ResetGlobalProvider()
+	}
+	reset()
+	t.Cleanup(reset)
+}
@@ -0,0 +1,195 @@
+package symbionttest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont"
+	"github.com/cleitonmarx/symbiont/depend"
+)
+
+// fakeT records failures instead of failing the real test. Fatalf ends the calling goroutine,
+// as testing.T does.
+type fakeT struct {
+	testing.TB
+	mu       sync.Mutex
+	failures []string
+	cleanups []func()
+}
+
+func (f *fakeT) Helper() {}
+
+func (f *fakeT) Cleanup(fn func()) {
+	f.cleanups = append(f.cleanups, fn)
+}
+
+func (f *fakeT) Fatalf(format string, args ...any) {
+	f.mu.Lock()
+	f.failures = append(f.failures, fmt.Sprintf(format, args...))
+	f.mu.Unlock()
+	runtime.Goexit()
+}
+
+// run calls fn and then the registered cleanups, in a goroutine that Fatalf may end.
+func (f *fakeT) run(fn func(t testing.TB)) []string {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer func() {
+			for i := len(f.cleanups) - 1; i >= 0; i-- {
+				f.runCleanup(f.cleanups[i])
+			}
+		}()
+		fn(f)
+	}()
+	<-done
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.failures
+}
+
+// runCleanup runs a cleanup in its own goroutine so a Fatalf inside it does not skip the others.
+func (f *fakeT) runCleanup(fn func()) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn()
+	}()
+	<-done
+}
+
+type blockingRunnable struct {
+	stopped chan struct{}
+	err     error
+	ignore  bool
+}
+
+func (r *blockingRunnable) Run(ctx context.Context) error {
+	<-ctx.Done()
+	if r.ignore {
+		time.Sleep(200 * time.Millisecond)
+	}
+	close(r.stopped)
+	return r.err
+}
+
+// failingRunnable fails once release is closed.
+type failingRunnable struct{ release chan struct{} }
+
+func (r failingRunnable) Run(context.Context) error {
+	<-r.release
+	return errors.New("boom")
+}
+
+func (failingRunnable) IsReady(context.Context) error { return nil }
+
+type failingInitializer struct{}
+
+func (failingInitializer) Initialize(context.Context) (context.Context, error) {
+	return nil, errors.New("no database")
+}
+
+type neverReady struct{}
+
+func (neverReady) Run(ctx context.Context) error     { <-ctx.Done(); return nil }
+func (neverReady) IsReady(ctx context.Context) error { return errors.New("warming up") }
+
+func TestRun(t *testing.T) {
+	release := make(chan struct{})
+	tests := map[string]struct {
+		app          func() *symbiont.App
+		exercise     func(h *Harness)
+		wantFailures []string
+	}{
+		"clean-stop": {
+			app: func() *symbiont.App {
+				return symbiont.NewApp().Host(&blockingRunnable{stopped: make(chan struct{})})
+			},
+			exercise: func(h *Harness) { h.Stop() },
+		},
+		"stopped-at-cleanup": {
+			app: func() *symbiont.App {
+				return symbiont.NewApp().Host(&blockingRunnable{stopped: make(chan struct{})})
+			},
+		},
+		"shutdown-error": {
+			app: func() *symbiont.App {
+				return symbiont.NewApp().Host(&blockingRunnable{stopped: make(chan struct{}), err: errors.New("close failed")})
+			},
+			exercise:     func(h *Harness) { h.Stop() },
+			wantFailures: []string{"symbionttest: app did not shut down cleanly: error: close failed"},
+		},
+		"shutdown-timeout": {
+			app: func() *symbiont.App {
+				return symbiont.NewApp().Host(&blockingRunnable{stopped: make(chan struct{}), ignore: true})
+			},
+			exercise:     func(h *Harness) { h.Stop() },
+			wantFailures: []string{"symbionttest: app did not shut down within 100ms"},
+		},
+		"stops-before-ready": {
+			app: func() *symbiont.App {
+				return symbiont.NewApp().Initialize(&failingInitializer{}).Host(&neverReady{})
+			},
+			wantFailures: []string{"symbionttest: app stopped before becoming ready: "},
+		},
+		"never-ready": {
+			app: func() *symbiont.App {
+				return symbiont.NewApp().Host(&neverReady{})
+			},
+			wantFailures: []string{"symbionttest: app did not become ready: "},
+		},
+		"wait-returns-runnable-error": {
+			app: func() *symbiont.App {
+				return symbiont.NewApp().Host(&failingRunnable{release: release})
+			},
+			exercise: func(h *Harness) {
+				close(release)
+				if err := h.Wait(); err == nil || !strings.Contains(err.Error(), "boom") {
+					h.t.Fatalf("unexpected error %v", err)
+				}
+				h.Stop()
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			Isolate(t)
+			ft := &fakeT{TB: t}
+			failures := ft.run(func(tb testing.TB) {
+				h := RunWithTimeout(tb, tt.app(), 100*time.Millisecond)
+				if tt.exercise != nil {
+					tt.exercise(h)
+				}
+			})
+			if len(failures) != len(tt.wantFailures) {
+				t.Fatalf("expected failures %q, got %q", tt.wantFailures, failures)
+			}
+			for i, want := range tt.wantFailures {
+				if !strings.HasPrefix(failures[i], want) {
+					t.Fatalf("expected failure %d to start with %q, got %q", i, want, failures[i])
+				}
+			}
+		})
+	}
+}
+
+func TestIsolate(t *testing.T) {
+	depend.Register("leaked")
+	t.Run("isolated", func(t *testing.T) {
+		Isolate(t)
+		if _, err := depend.Resolve[string](); err == nil {
+			t.Fatal("expected the container to be cleared")
+		}
+		depend.Register("inner")
+	})
+	if _, err := depend.Resolve[string](); err == nil {
+		t.Fatal("expected the container to be cleared when the test ends")
+	}
+}
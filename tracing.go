@@ -0,0 +1,81 @@
+package symbiont
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Tracer starts spans around component lifecycle phases.
+// It keeps the core free of a tracing SDK; the otelspan package adapts an OpenTelemetry tracer.
+type Tracer interface {
+	// Start begins a span with the given name as a child of any span in ctx, and returns a copy of
+	// ctx carrying the new span.
+	Start(ctx context.Context, spanName string) (context.Context, Span)
+}
+
+// Span is a span started by a Tracer.
+type Span interface {
+	// End finishes the span, recording err when it is not nil.
+	End(err error)
+}
+
+// noopSpan is used when no tracer is set.
+type noopSpan struct{}
+
+func (noopSpan) End(error) {}
+
+// WithTracer records a span around each initializer's Initialize call and around the startup of
+// each runnable's first run (fluent method). Spans are named after the phase and component type, as in
+// "Initialize *app.InitDB" or "Start *app.Server".
+//
+// An Initialize span ends with the error Initialize returned. A Start span ends once the runnable
+// reports ready, or with the error Run returned if it returns first, so it measures the runnable's
+// startup latency. The context passed to Run carries the Start span, so spans the runnable starts
+// from it are nested under it. Initializers are not given their span's context, because the context
+// an initializer returns becomes the context of every later component.
+// A nil tracer disables tracing, which is the default.
+func (a *App) WithTracer(t Tracer) *App {
+	a.tracer = t
+	return a
+}
+
+// startSpan starts a lifecycle span for component, or a no-op span when no tracer is set.
+func (a *App) startSpan(ctx context.Context, phase string, component any) (context.Context, Span) {
+	if a.tracer == nil {
+		return ctx, noopSpan{}
+	}
+	return a.tracer.Start(ctx, phase+" "+componentName(component))
+}
+
+// startupPollInterval is how often the ready checker of a traced runnable is polled to end its Start span.
+const startupPollInterval = 10 * time.Millisecond
+
+// endWhenReady ends span once rc reports ready, polling it in the background until ctx is done.
+// The returned function ends the span with the error Run returned, unless it already ended.
+func endWhenReady(ctx context.Context, span Span, rc ReadyChecker) func(err error) {
+	var once sync.Once
+	end := func(err error) { once.Do(func() { span.End(err) }) }
+	stop := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(startupPollInterval)
+		defer ticker.Stop()
+		for {
+			if rc.IsReady(ctx) == nil {
+				end(nil)
+				return
+			}
+			select {
+			case <-stop:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return func(err error) {
+		close(stop)
+		end(err)
+	}
+}
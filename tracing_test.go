@@ -0,0 +1,125 @@
+package symbiont
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cleitonmarx/symbiont/depend"
+)
+
+// recordingTracer records the spans it starts and how they ended.
+type recordingTracer struct {
+	mu    sync.Mutex
+	spans []string
+}
+
+// spanNameKey is the context key under which recordingTracer stores the name of the current span.
+type spanNameKey struct{}
+
+func (r *recordingTracer) Start(ctx context.Context, spanName string) (context.Context, Span) {
+	return context.WithValue(ctx, spanNameKey{}, spanName), &recordingSpan{tracer: r, name: spanName}
+}
+
+func (r *recordingTracer) ended() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.spans)
+}
+
+type recordingSpan struct {
+	tracer *recordingTracer
+	name   string
+}
+
+func (s *recordingSpan) End(err error) {
+	s.tracer.mu.Lock()
+	defer s.tracer.mu.Unlock()
+	entry := s.name
+	if err != nil {
+		entry += " (error)"
+	}
+	s.tracer.spans = append(s.tracer.spans, entry)
+}
+
+func TestApp_WithTracer(t *testing.T) {
+	tests := map[string]struct {
+		inits     []Initializer
+		runs      []Runnable
+		wantSpans []string
+	}{
+		"spans-for-initializers-and-runnables": {
+			inits:     []Initializer{&recCloser{name: "init", log: &[]string{}}},
+			runs:      []Runnable{&runCloser{name: "run", log: &[]string{}}},
+			wantSpans: []string{"Initialize *symbiont.recCloser", "Start *symbiont.runCloser"},
+		},
+		"failed-initializer-span-records-error": {
+			inits:     []Initializer{&errInitializer{}},
+			wantSpans: []string{"Initialize *symbiont.errInitializer (error)"},
+		},
+		"failed-runnable-span-records-error": {
+			runs:      []Runnable{&runCloser{name: "run", log: &[]string{}, willErr: true}},
+			wantSpans: []string{"Start *symbiont.runCloser (error)"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			depend.ClearContainer()
+			tracer := &recordingTracer{}
+			_ = NewApp().
+				WithTracer(tracer).
+				Initialize(tt.inits...).
+				Host(tt.runs...).
+				RunWithContext(context.Background())
+
+			if !reflect.DeepEqual(tt.wantSpans, tracer.spans) {
+				t.Fatalf("expected spans %v, got %v", tt.wantSpans, tracer.spans)
+			}
+		})
+	}
+}
+
+// spanRecordingRunnable records the span carried by its context and waits until it is canceled.
+type spanRecordingRunnable struct {
+	gotSpan any
+	running chan struct{}
+}
+
+func (s *spanRecordingRunnable) Run(ctx context.Context) error {
+	s.gotSpan = ctx.Value(spanNameKey{})
+	close(s.running)
+	<-ctx.Done()
+	return nil
+}
+
+func TestApp_WithTracer_StartSpan(t *testing.T) {
+	depend.ClearContainer()
+	tracer := &recordingTracer{}
+	run := &spanRecordingRunnable{running: make(chan struct{})}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errCh := NewApp().WithTracer(tracer).Host(run).RunAsync(ctx)
+
+	// The Start span ends once the runnable is ready, while Run is still running.
+	deadline := time.Now().Add(time.Second)
+	for len(tracer.ended()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if got := tracer.ended(); !slices.Equal(got, []string{"Start *symbiont.spanRecordingRunnable"}) {
+		t.Fatalf("expected the Start span to end while running, got %v", got)
+	}
+	cancel()
+	if err := <-errCh; err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if run.gotSpan != "Start *symbiont.spanRecordingRunnable" {
+		t.Fatalf("expected Run to receive the span context, got %v", run.gotSpan)
+	}
+	if got := tracer.ended(); len(got) != 1 {
+		t.Fatalf("expected the span to end once, got %v", got)
+	}
+}
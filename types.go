@@ -8,12 +8,37 @@ type Runnable interface {
 	Run(context.Context) error
 }
 
+// OneShot is an optional marker interface for runnables that finish their job and return, such as a
+// database migration, as opposed to long-lived servers. A one-shot runnable that returns nil never ends
+// the app: the app keeps running until its long-lived runnables return or, when every hosted runnable is
+// one-shot, until the run context is canceled. A one-shot runnable that returns an error still stops the
+// app like any other runnable.
+type OneShot interface {
+	OneShot()
+}
+
 // Closer releases resources and is called during graceful shutdown.
 // Closers are invoked in LIFO (reverse registration) order.
 type Closer interface {
 	Close()
 }
 
+// ContextCloser releases resources within a deadline and is called during graceful shutdown.
+// It is preferred over Closer: Close receives a context bounded by the app's shutdown timeout,
+// and its error is included in the error returned by Run.
+type ContextCloser interface {
+	Close(ctx context.Context) error
+}
+
+// ClosePrioritizer is an optional interface for Closer and ContextCloser components whose Close must
+// run before or after others regardless of registration order, such as flushing telemetry before
+// the logger closes. Closers run from the highest priority to the lowest; closers of equal priority,
+// including components without a priority (0), run in LIFO order. Functions registered with
+// RegisterCloser share the priority of their initializer.
+type ClosePrioritizer interface {
+	ClosePriority() int
+}
+
 // Initializer sets up component resources during application startup.
 // It can register dependencies and return an updated context for propagation to other components.
 // Errors halt initialization immediately; panics are recovered and reported.
@@ -26,3 +51,17 @@ type Initializer interface {
 type ReadyChecker interface {
 	IsReady(ctx context.Context) error
 }
+
+// ConfigPrefixer scopes the config keys of a component's tagged fields.
+// When a component implements it, its config:"KEY" fields are read as ConfigPrefix()+KEY,
+// which lets several instances of the same type read distinct keys.
+type ConfigPrefixer interface {
+	ConfigPrefix() string
+}
+
+// Named gives a hosted runnable an instance name for introspection.
+// Runners of the same type are otherwise indistinguishable in reports and graphs; a named runner
+// is reported as "Type[name]".
+type Named interface {
+	Name() string
+}